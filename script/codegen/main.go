@@ -145,6 +145,13 @@ var funcData []FuncTemplateData = []FuncTemplateData{
 		JsonName:    "organizations",
 		FileName:    "organization",
 	},
+	{
+		FuncName:    "Requests",
+		ObjectName:  "Request",
+		ApiEndpoint: "/requests.json",
+		JsonName:    "requests",
+		FileName:    "request",
+	},
 	{
 		FuncName:    "Search",
 		ObjectName:  "SearchResults",
@@ -152,6 +159,13 @@ var funcData []FuncTemplateData = []FuncTemplateData{
 		JsonName:    "results",
 		FileName:    "search",
 	},
+	{
+		FuncName:    "SatisfactionRatings",
+		ObjectName:  "SatisfactionRating",
+		ApiEndpoint: "/satisfaction_ratings.json",
+		JsonName:    "satisfaction_ratings",
+		FileName:    "satisfaction_rating",
+	},
 	{
 		FuncName:    "SLAPolicies",
 		ObjectName:  "SLAPolicy",
@@ -159,6 +173,13 @@ var funcData []FuncTemplateData = []FuncTemplateData{
 		JsonName:    "sla_policies",
 		FileName:    "sla_policy",
 	},
+	{
+		FuncName:    "SuspendedTickets",
+		ObjectName:  "SuspendedTicket",
+		ApiEndpoint: "/suspended_tickets.json",
+		JsonName:    "suspended_tickets",
+		FileName:    "suspended_ticket",
+	},
 	{
 		FuncName:    "AllTicketAudits",
 		ObjectName:  "TicketAudit",
@@ -247,6 +268,13 @@ var funcData []FuncTemplateData = []FuncTemplateData{
 		FileName:    "ticket_comment",
 		ExtraParam:  true,
 	},
+	{
+		FuncName:    "TicketMetrics",
+		ObjectName:  "TicketMetric",
+		ApiEndpoint: "/ticket_metrics.json",
+		JsonName:    "ticket_metrics",
+		FileName:    "ticket_metrics",
+	},
 	{
 		FuncName:    "Groups",
 		ObjectName:  "Group",
@@ -262,6 +290,81 @@ var funcData []FuncTemplateData = []FuncTemplateData{
 		FileName:    "organization_tickets",
 		ExtraParam:  true,
 	},
+	{
+		FuncName:    "TicketIncidents",
+		ObjectName:  "Ticket",
+		ApiEndpoint: "/tickets/%d/incidents.json",
+		JsonName:    "tickets",
+		FileName:    "ticket_incidents",
+		ExtraParam:  true,
+	},
+	{
+		FuncName:    "Problems",
+		ObjectName:  "Ticket",
+		ApiEndpoint: "/problems.json",
+		JsonName:    "tickets",
+		FileName:    "problems",
+	},
+	{
+		FuncName:    "UserSkips",
+		ObjectName:  "Skip",
+		ApiEndpoint: "/users/%d/skips.json",
+		JsonName:    "skips",
+		FileName:    "user_skips",
+		ExtraParam:  true,
+	},
+	{
+		FuncName:    "TicketSkips",
+		ObjectName:  "Skip",
+		ApiEndpoint: "/tickets/%d/skips.json",
+		JsonName:    "skips",
+		FileName:    "ticket_skips",
+		ExtraParam:  true,
+	},
+	{
+		FuncName:    "Activities",
+		ObjectName:  "Activity",
+		ApiEndpoint: "/activities.json",
+		JsonName:    "activities",
+		FileName:    "activity",
+	},
+	{
+		FuncName:    "DeletedUsers",
+		ObjectName:  "User",
+		ApiEndpoint: "/deleted_users.json",
+		JsonName:    "deleted_users",
+		FileName:    "deleted_user",
+	},
+	{
+		FuncName:    "UserIdentities",
+		ObjectName:  "UserIdentity",
+		ApiEndpoint: "/users/%d/identities.json",
+		JsonName:    "identities",
+		FileName:    "user_identity",
+		ExtraParam:  true,
+	},
+	{
+		FuncName:    "Sessions",
+		ObjectName:  "Session",
+		ApiEndpoint: "/sessions.json",
+		JsonName:    "sessions",
+		FileName:    "session",
+	},
+	{
+		FuncName:    "UserSessions",
+		ObjectName:  "Session",
+		ApiEndpoint: "/users/%d/sessions.json",
+		JsonName:    "sessions",
+		FileName:    "user_sessions",
+		ExtraParam:  true,
+	},
+	{
+		FuncName:    "OrganizationSubscriptions",
+		ObjectName:  "OrganizationSubscription",
+		ApiEndpoint: "/organization_subscriptions.json",
+		JsonName:    "organization_subscriptions",
+		FileName:    "organization_subscription",
+	},
 }
 
 func main() {
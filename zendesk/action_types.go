@@ -1,5 +1,11 @@
 package zendesk
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // action field types which defined by system
 // https://developer.zendesk.com/rest_api/docs/core/triggers#actions-reference
 const (
@@ -72,3 +78,208 @@ var actionFieldText = map[int]string{
 func ActionFieldText(fieldType int) string {
 	return actionFieldText[fieldType]
 }
+
+// ActionFieldFromText takes field name string and returns field type.
+// ok is false if the name is not a field defined by system.
+func ActionFieldFromText(name string) (fieldType int, ok bool) {
+	for t, text := range actionFieldText {
+		if text == name {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// actionValues is the values accepted by fields with a fixed set of values
+var actionValues = map[int][]string{
+	ActionFieldStatus:              {"new", "open", "pending", "hold", "solved", "closed"},
+	ActionFieldPriority:            {"low", "normal", "high", "urgent"},
+	ActionFieldType:                {"question", "incident", "problem", "task"},
+	ActionFieldCommentModeIsPublic: {"true", "false"},
+}
+
+// actionValueLengths is the length of list values taken by notification actions
+var actionValueLengths = map[int]int{
+	ActionFieldNotificationUser:   3,
+	ActionFieldNotificationGroup:  3,
+	ActionFieldNotificationTarget: 2,
+}
+
+// Action is an action of business rules built with Act.
+// It can be converted into the action type of triggers, automations and macros.
+// Value is a string, or a []string for notification actions.
+type Action struct {
+	Field string
+	Value interface{}
+}
+
+// Validate checks the value is valid for the field
+func (a Action) Validate() error {
+	fieldType, ok := ActionFieldFromText(a.Field)
+	if !ok {
+		if strings.HasPrefix(a.Field, "custom_fields_") {
+			return nil
+		}
+		return fmt.Errorf("unknown action field: %s", a.Field)
+	}
+
+	if n, ok := actionValueLengths[fieldType]; ok {
+		list, ok := a.Value.([]string)
+		if !ok || len(list) != n {
+			return fmt.Errorf("action field %s takes a list of %d values", a.Field, n)
+		}
+		return nil
+	}
+
+	value, ok := a.Value.(string)
+	if !ok {
+		return fmt.Errorf("action field %s takes a string value", a.Field)
+	}
+	values, ok := actionValues[fieldType]
+	if ok && !containsString(values, value) {
+		return fmt.Errorf("invalid value for action field %s: %s", a.Field, value)
+	}
+	return nil
+}
+
+// TriggerAction converts the action into a trigger action
+func (a Action) TriggerAction() TriggerAction {
+	return TriggerAction{Field: a.Field, Value: a.Value}
+}
+
+// AutomationAction converts the action into an automation action
+func (a Action) AutomationAction() AutomationAction {
+	return AutomationAction{Field: a.Field, Value: a.Value}
+}
+
+// MacroAction converts the action into a macro action.
+// Macros can not take actions with a list value such as notifications.
+func (a Action) MacroAction() (MacroAction, error) {
+	value, ok := a.Value.(string)
+	if !ok {
+		return MacroAction{}, fmt.Errorf("action field %s can not be used in macros", a.Field)
+	}
+	return MacroAction{Field: a.Field, Value: value}, nil
+}
+
+// ActionBuilder builds actions of business rules.
+// Use Act to build actions, e.g. Act.AddTags("vip").
+type ActionBuilder struct{}
+
+// Act builds actions of business rules
+var Act = ActionBuilder{}
+
+func newAction(fieldType int, value interface{}) Action {
+	return Action{Field: ActionFieldText(fieldType), Value: value}
+}
+
+// Status sets the status of the ticket
+func (ActionBuilder) Status(status string) Action {
+	return newAction(ActionFieldStatus, status)
+}
+
+// Type sets the type of the ticket
+func (ActionBuilder) Type(ticketType string) Action {
+	return newAction(ActionFieldType, ticketType)
+}
+
+// Priority sets the priority of the ticket
+func (ActionBuilder) Priority(priority string) Action {
+	return newAction(ActionFieldPriority, priority)
+}
+
+// GroupID assigns the ticket to the group
+func (ActionBuilder) GroupID(groupID int64) Action {
+	return newAction(ActionFieldGroupID, strconv.FormatInt(groupID, 10))
+}
+
+// AssigneeID assigns the ticket to the agent
+func (ActionBuilder) AssigneeID(assigneeID int64) Action {
+	return newAction(ActionFieldAssigneeID, strconv.FormatInt(assigneeID, 10))
+}
+
+// AssigneeToCurrentUser assigns the ticket to the user who updates the ticket
+func (ActionBuilder) AssigneeToCurrentUser() Action {
+	return newAction(ActionFieldAssigneeID, "current_user")
+}
+
+// SetTags replaces the tags of the ticket
+func (ActionBuilder) SetTags(tags ...string) Action {
+	return newAction(ActionFieldSetTags, strings.Join(tags, " "))
+}
+
+// AddTags adds the tags to the ticket
+func (ActionBuilder) AddTags(tags ...string) Action {
+	return newAction(ActionFieldCurrentTags, strings.Join(tags, " "))
+}
+
+// RemoveTags removes the tags from the ticket
+func (ActionBuilder) RemoveTags(tags ...string) Action {
+	return newAction(ActionFieldRemoveTags, strings.Join(tags, " "))
+}
+
+// SatisfactionScore sets the satisfaction score, e.g. "offered"
+func (ActionBuilder) SatisfactionScore(score string) Action {
+	return newAction(ActionFieldSatisfactionScore, score)
+}
+
+// NotifyUser emails the user. user is a user ID or one of
+// "current_user", "requester_id", "assignee_id" and "all_agents".
+func (ActionBuilder) NotifyUser(user, subject, body string) Action {
+	return newAction(ActionFieldNotificationUser, []string{user, subject, body})
+}
+
+// NotifyGroup emails the group. group is a group ID or "group_id" for the group of the ticket.
+func (ActionBuilder) NotifyGroup(group, subject, body string) Action {
+	return newAction(ActionFieldNotificationGroup, []string{group, subject, body})
+}
+
+// NotifyTarget sends the message to the target
+func (ActionBuilder) NotifyTarget(targetID int64, message string) Action {
+	return newAction(ActionFieldNotificationTarget, []string{strconv.FormatInt(targetID, 10), message})
+}
+
+// TweetRequester replies to the requester on Twitter
+func (ActionBuilder) TweetRequester(message string) Action {
+	return newAction(ActionFieldTweetRequester, message)
+}
+
+// CC adds the user to CCs of the ticket. user is a user ID or "current_user".
+func (ActionBuilder) CC(user string) Action {
+	return newAction(ActionFieldCC, user)
+}
+
+// LocaleID sets the language of the requester
+func (ActionBuilder) LocaleID(localeID int64) Action {
+	return newAction(ActionFieldLocaleID, strconv.FormatInt(localeID, 10))
+}
+
+// Subject sets the subject of the ticket
+func (ActionBuilder) Subject(subject string) Action {
+	return newAction(ActionFieldSubject, subject)
+}
+
+// Comment adds a comment to the ticket
+func (ActionBuilder) Comment(comment string) Action {
+	return newAction(ActionFieldCommentValue, comment)
+}
+
+// CommentHTML adds a comment written in HTML to the ticket
+func (ActionBuilder) CommentHTML(comment string) Action {
+	return newAction(ActionFieldCommentValueHTML, comment)
+}
+
+// CommentModeIsPublic sets whether the comment is public
+func (ActionBuilder) CommentModeIsPublic(public bool) Action {
+	return newAction(ActionFieldCommentModeIsPublic, strconv.FormatBool(public))
+}
+
+// TicketFormID sets the ticket form of the ticket
+func (ActionBuilder) TicketFormID(formID int64) Action {
+	return newAction(ActionFieldTicketFormID, strconv.FormatInt(formID, 10))
+}
+
+// CustomField sets the value of a custom ticket field
+func (ActionBuilder) CustomField(fieldID int64, value string) Action {
+	return Action{Field: fmt.Sprintf("custom_fields_%d", fieldID), Value: value}
+}
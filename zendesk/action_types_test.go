@@ -7,3 +7,65 @@ func TestActionFieldText(t *testing.T) {
 		t.Fatal(`expected "status", but got ` + action)
 	}
 }
+
+func TestActionFieldFromText(t *testing.T) {
+	for fieldType := ActionFieldStatus; fieldType <= ActionFieldTicketFormID; fieldType++ {
+		name := ActionFieldText(fieldType)
+		if name == "" {
+			t.Fatalf("field type %d has no name", fieldType)
+		}
+		if got, ok := ActionFieldFromText(name); !ok || got != fieldType {
+			t.Fatalf("expected %d for %s, but got %d", fieldType, name, got)
+		}
+	}
+
+	if _, ok := ActionFieldFromText("unknown"); ok {
+		t.Fatal("expected unknown field not to be found")
+	}
+}
+
+func TestAct(t *testing.T) {
+	cases := []struct {
+		action   Action
+		expected Action
+	}{
+		{Act.Status("solved"), Action{"status", "solved"}},
+		{Act.AddTags("vip", "urgent"), Action{"current_tags", "vip urgent"}},
+		{Act.RemoveTags("spam"), Action{"remove_tags", "spam"}},
+		{Act.AssigneeID(123), Action{"assignee_id", "123"}},
+		{Act.CommentModeIsPublic(false), Action{"comment_mode_is_public", "false"}},
+		{Act.CustomField(360001, "foo"), Action{"custom_fields_360001", "foo"}},
+	}
+
+	for _, c := range cases {
+		if c.action != c.expected {
+			t.Fatalf("expected %v, but got %v", c.expected, c.action)
+		}
+		if err := c.action.Validate(); err != nil {
+			t.Fatalf("failed to validate %v: %s", c.action, err)
+		}
+	}
+
+	notify := Act.NotifyUser("requester_id", "subject", "body")
+	if err := notify.Validate(); err != nil {
+		t.Fatalf("failed to validate notification: %s", err)
+	}
+	if _, err := notify.MacroAction(); err == nil {
+		t.Fatal("expected notification not to be converted into a macro action")
+	}
+}
+
+func TestActionValidate(t *testing.T) {
+	invalid := []Action{
+		{Field: "unknown", Value: "x"},
+		{Field: "status", Value: "unknown"},
+		{Field: "notification_target", Value: []string{"1"}},
+		{Field: "subject", Value: []string{"a"}},
+	}
+
+	for _, action := range invalid {
+		if err := action.Validate(); err == nil {
+			t.Fatalf("expected %v to be invalid", action)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Activity is struct for activity stream payload.
+// Activities are events such as ticket assignments and comments
+// which concern the authenticated agent.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/activity_stream/
+type Activity struct {
+	ID        int64                  `json:"id,omitempty"`
+	URL       string                 `json:"url,omitempty"`
+	Verb      string                 `json:"verb,omitempty"`
+	Title     string                 `json:"title,omitempty"`
+	UserID    int64                  `json:"user_id,omitempty"`
+	ActorID   int64                  `json:"actor_id,omitempty"`
+	User      *User                  `json:"user,omitempty"`
+	Actor     *User                  `json:"actor,omitempty"`
+	Object    map[string]interface{} `json:"object,omitempty"`
+	Target    map[string]interface{} `json:"target,omitempty"`
+	CreatedAt *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt *time.Time             `json:"updated_at,omitempty"`
+}
+
+// ActivityListOptions is options for GetActivities
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/activity_stream/#list-activities
+type ActivityListOptions struct {
+	PageOptions
+
+	// Since is a UTC time in ISO 8601 format, e.g. "2023-01-01T00:00:00Z"
+	Since string `url:"since,omitempty"`
+}
+
+// ActivityAPI an interface containing all activity stream related methods
+type ActivityAPI interface {
+	GetActivities(ctx context.Context, opts *ActivityListOptions) ([]Activity, Page, error)
+	GetActivity(ctx context.Context, id int64) (Activity, error)
+	GetActivitiesIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Activity]
+	GetActivitiesOBP(ctx context.Context, opts *OBPOptions) ([]Activity, Page, error)
+	GetActivitiesCBP(ctx context.Context, opts *CBPOptions) ([]Activity, CursorPaginationMeta, error)
+}
+
+// GetActivities fetch activities of the authenticated agent
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/activity_stream/#list-activities
+func (z *Client) GetActivities(ctx context.Context, opts *ActivityListOptions) ([]Activity, Page, error) {
+	var data struct {
+		Activities []Activity `json:"activities"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ActivityListOptions{}
+	}
+
+	u, err := addOptions("/activities.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Activities, data.Page, nil
+}
+
+// GetActivity gets a specified activity
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/activity_stream/#show-activity
+func (z *Client) GetActivity(ctx context.Context, id int64) (Activity, error) {
+	var result struct {
+		Activity Activity `json:"activity"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/activities/%d.json", id))
+	if err != nil {
+		return Activity{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Activity{}, err
+	}
+	return result.Activity, nil
+}
@@ -0,0 +1,74 @@
+
+// Code generated by Script. DO NOT EDIT.
+// Source: script/codegen/main.go
+//
+// Generated by this command:
+//
+//	go run script/codegen/main.go
+
+package zendesk
+
+import "context"
+
+func (z *Client) GetActivitiesIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Activity] {
+	return &Iterator[Activity]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         opts.IsCBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc:       z.GetActivitiesOBP,
+		cbpFunc:       z.GetActivitiesCBP,
+	}
+}
+
+func (z *Client) GetActivitiesOBP(ctx context.Context, opts *OBPOptions) ([]Activity, Page, error) {
+	var data struct {
+		Activitys []Activity `json:"activities"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OBPOptions{}
+	}
+	
+	u, err := addOptions("/activities.json", tmp)
+	
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Activitys, data.Page, nil
+}
+
+func (z *Client) GetActivitiesCBP(ctx context.Context, opts *CBPOptions) ([]Activity, CursorPaginationMeta, error) {
+	var data struct {
+		Activitys []Activity `json:"activities"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+	
+	u, err := addOptions("/activities.json", tmp)
+	
+	if err != nil {
+		return nil, data.Meta, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	return data.Activitys, data.Meta, nil
+}
+
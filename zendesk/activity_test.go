@@ -0,0 +1,39 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetActivities(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "activities.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	activities, _, err := client.GetActivities(ctx, &ActivityListOptions{Since: "2023-03-01T00:00:00Z"})
+	if err != nil {
+		t.Fatalf("Failed to get activities: %s", err)
+	}
+
+	if len(activities) != 1 {
+		t.Fatalf("expected length of activities is 1, but got %d", len(activities))
+	}
+	if activities[0].Actor == nil || activities[0].Actor.ID != 23546 {
+		t.Fatalf("expected actor 23546, but got %v", activities[0].Actor)
+	}
+}
+
+func TestGetActivity(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "activity.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	activity, err := client.GetActivity(ctx, 35)
+	if err != nil {
+		t.Fatalf("Failed to get activity: %s", err)
+	}
+
+	if activity.Verb != "tickets.comment" {
+		t.Fatalf("expected verb of activity is tickets.comment, but got %s", activity.Verb)
+	}
+}
@@ -5,32 +5,47 @@ package zendesk
 
 // API an interface containing all of the zendesk client methods
 type API interface {
+	ActivityAPI
 	AppAPI
 	AttachmentAPI
 	AutomationAPI
 	BaseAPI
 	BrandAPI
 	CustomRoleAPI
+	CustomStatusAPI
 	DynamicContentAPI
 	GroupAPI
 	GroupMembershipAPI
+	JobStatusAPI
 	LocaleAPI
 	MacroAPI
 	OrganizationAPI
 	OrganizationFieldAPI
 	OrganizationMembershipAPI
+	OrganizationSubscriptionAPI
+	RequestAPI
+	SatisfactionRatingAPI
+	ScheduleAPI
 	SearchAPI
+	SessionAPI
+	SideConversationAPI
+	SkipAPI
 	SLAPolicyAPI
+	SuspendedTicketAPI
 	TagAPI
 	TargetAPI
 	TicketAuditAPI
+	TicketRelationshipAPI
 	TicketAPI
 	TicketCommentAPI
 	TicketFieldAPI
 	TicketFormAPI
+	TicketMetricsAPI
 	TriggerAPI
 	UserAPI
 	UserFieldAPI
+	UserIdentityAPI
+	UserPasswordAPI
 	ViewAPI
 	WebhookAPI
 	CustomObjectAPI
@@ -0,0 +1,42 @@
+package zendesk
+
+import (
+	"strconv"
+	"strings"
+)
+
+// chunk splits items into slices of at most size elements.
+// It is used to stay within the limits of the *_many endpoints.
+func chunk[T any](items []T, size int) [][]T {
+	var chunks [][]T
+	for size < len(items) {
+		items, chunks = items[size:], append(chunks, items[:size])
+	}
+	if len(items) > 0 {
+		chunks = append(chunks, items)
+	}
+	return chunks
+}
+
+// joinIDs formats IDs as the comma separated list taken by the *_many endpoints
+func joinIDs(ids []int64) string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(strs, ",")
+}
+
+// chunkJobs calls job for each chunk of items and collects the job statuses.
+// It stops at the first error and returns the job statuses started so far.
+func chunkJobs[T any](items []T, size int, job func([]T) (JobStatus, error)) ([]JobStatus, error) {
+	var jobStatuses []JobStatus
+	for _, c := range chunk(items, size) {
+		jobStatus, err := job(c)
+		if err != nil {
+			return jobStatuses, err
+		}
+		jobStatuses = append(jobStatuses, jobStatus)
+	}
+	return jobStatuses, nil
+}
@@ -0,0 +1,25 @@
+package zendesk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	ids := []int64{1, 2, 3, 4, 5}
+
+	expected := [][]int64{{1, 2}, {3, 4}, {5}}
+	if chunks := chunk(ids, 2); !reflect.DeepEqual(chunks, expected) {
+		t.Fatalf("expected %v, but got %v", expected, chunks)
+	}
+
+	if chunks := chunk([]int64{}, 2); len(chunks) != 0 {
+		t.Fatalf("expected no chunks, but got %v", chunks)
+	}
+}
+
+func TestJoinIDs(t *testing.T) {
+	if s := joinIDs([]int64{1, 22, 333}); s != "1,22,333" {
+		t.Fatalf("expected %s, but got %s", "1,22,333", s)
+	}
+}
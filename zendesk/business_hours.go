@@ -0,0 +1,192 @@
+package zendesk
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// BusinessHours calculates business hours of a schedule locally,
+// e.g. to predict when an SLA target measured in business minutes is breached.
+// Holidays are whole days in the time zone of the schedule.
+type BusinessHours struct {
+	location  *time.Location
+	intervals []ScheduleInterval
+	holidays  [][2]time.Time
+}
+
+// businessWindow is a period of business hours on a specific date
+type businessWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// NewBusinessHours creates a calculator from a schedule and its holidays
+func NewBusinessHours(schedule Schedule, holidays []ScheduleHoliday) (*BusinessHours, error) {
+	location, err := schedule.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(schedule.Intervals) == 0 {
+		return nil, errors.New("schedule has no intervals")
+	}
+
+	intervals := make([]ScheduleInterval, len(schedule.Intervals))
+	copy(intervals, schedule.Intervals)
+	for _, interval := range intervals {
+		if err := interval.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].StartTime < intervals[j].StartTime
+	})
+
+	b := &BusinessHours{
+		location:  location,
+		intervals: intervals,
+	}
+	for _, holiday := range holidays {
+		start, err := time.Parse(ScheduleHolidayDateFormat, holiday.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start date of holiday %q: %w", holiday.Name, err)
+		}
+		end, err := time.Parse(ScheduleHolidayDateFormat, holiday.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end date of holiday %q: %w", holiday.Name, err)
+		}
+		b.holidays = append(b.holidays, [2]time.Time{start, end})
+	}
+	return b, nil
+}
+
+// IsBusinessTime returns true if t is within business hours
+func (b *BusinessHours) IsBusinessTime(t time.Time) bool {
+	for _, w := range b.windows(b.date(t)) {
+		if !t.Before(w.start) && t.Before(w.end) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddBusinessMinutes returns the time when the given number of business minutes
+// have elapsed since t. If t is outside business hours, counting starts
+// at the beginning of the next business hours.
+func (b *BusinessHours) AddBusinessMinutes(t time.Time, minutes int) (time.Time, error) {
+	if minutes < 0 {
+		return time.Time{}, fmt.Errorf("business minutes must not be negative: %d", minutes)
+	}
+
+	return b.addBusinessDuration(t, time.Duration(minutes)*time.Minute), nil
+}
+
+// addBusinessDuration returns the time when d of business hours have elapsed since t
+func (b *BusinessHours) addBusinessDuration(t time.Time, d time.Duration) time.Time {
+	remaining := d
+	for date := b.date(t); ; date = date.AddDate(0, 0, 1) {
+		for _, w := range b.windows(date) {
+			if !w.end.After(t) {
+				continue
+			}
+
+			start := w.start
+			if start.Before(t) {
+				start = t
+			}
+			available := w.end.Sub(start)
+			if remaining <= available {
+				return start.Add(remaining)
+			}
+			remaining -= available
+		}
+	}
+}
+
+// BusinessMinutesBetween returns the number of whole business minutes between from and to.
+// It is negative if to is before from.
+func (b *BusinessHours) BusinessMinutesBetween(from, to time.Time) int {
+	return int(b.businessDuration(from, to) / time.Minute)
+}
+
+// businessDuration returns the business hours between from and to
+func (b *BusinessHours) businessDuration(from, to time.Time) time.Duration {
+	if to.Before(from) {
+		return -b.businessDuration(to, from)
+	}
+
+	var total time.Duration
+	last := b.date(to)
+	for date := b.date(from); !date.After(last); date = date.AddDate(0, 0, 1) {
+		for _, w := range b.windows(date) {
+			start, end := w.start, w.end
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+	}
+	return total
+}
+
+// TimeDuration returns the business and calendar minutes between from and to
+// in the same form as the durations of TicketMetric, e.g. ReplyTimeInMinutes
+func (b *BusinessHours) TimeDuration(from, to time.Time) TimeDuration {
+	return TimeDuration{
+		Business: b.BusinessMinutesBetween(from, to),
+		Calendar: int(to.Sub(from) / time.Minute),
+	}
+}
+
+// date returns the date of t in the time zone of the schedule, in UTC
+func (b *BusinessHours) date(t time.Time) time.Time {
+	y, m, d := t.In(b.location).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func (b *BusinessHours) isHoliday(date time.Time) bool {
+	for _, h := range b.holidays {
+		if !date.Before(h[0]) && !date.After(h[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// windows returns the business hours on the given date in chronological order
+func (b *BusinessHours) windows(date time.Time) []businessWindow {
+	if b.isHoliday(date) {
+		return nil
+	}
+
+	y, m, d := date.Date()
+	dayStart := int(date.Weekday()) * 24 * 60
+	dayEnd := dayStart + 24*60
+
+	var windows []businessWindow
+	for _, interval := range b.intervals {
+		start, end := interval.StartTime, interval.EndTime
+		if start < dayStart {
+			start = dayStart
+		}
+		if end > dayEnd {
+			end = dayEnd
+		}
+		if start >= end {
+			continue
+		}
+
+		windows = append(windows, businessWindow{
+			start: time.Date(y, m, d, 0, start-dayStart, 0, 0, b.location),
+			end:   time.Date(y, m, d, 0, end-dayStart, 0, 0, b.location),
+		})
+	}
+	return windows
+}
@@ -0,0 +1,140 @@
+package zendesk
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestBusinessHours(t *testing.T) (*BusinessHours, *time.Location) {
+	schedule := Schedule{TimeZone: "Eastern Time (US & Canada)"}
+	for day := time.Monday; day <= time.Friday; day++ {
+		schedule.Intervals = append(schedule.Intervals, NewScheduleInterval(day, 9, 0, 17, 0))
+	}
+
+	b, err := NewBusinessHours(schedule, []ScheduleHoliday{
+		{Name: "Christmas", StartDate: "2023-12-25", EndDate: "2023-12-25"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create business hours: %s", err)
+	}
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b, loc
+}
+
+func TestBusinessHoursIsBusinessTime(t *testing.T) {
+	b, loc := newTestBusinessHours(t)
+
+	cases := []struct {
+		time     time.Time
+		expected bool
+	}{
+		{time.Date(2023, 12, 20, 9, 0, 0, 0, loc), true},
+		{time.Date(2023, 12, 20, 16, 59, 0, 0, loc), true},
+		{time.Date(2023, 12, 20, 17, 0, 0, 0, loc), false},
+		{time.Date(2023, 12, 20, 8, 59, 0, 0, loc), false},
+		// Saturday
+		{time.Date(2023, 12, 23, 12, 0, 0, 0, loc), false},
+		// Christmas
+		{time.Date(2023, 12, 25, 12, 0, 0, 0, loc), false},
+		// 14:00 UTC is 9:00 in New York
+		{time.Date(2023, 12, 20, 14, 0, 0, 0, time.UTC), true},
+	}
+	for _, c := range cases {
+		if actual := b.IsBusinessTime(c.time); actual != c.expected {
+			t.Errorf("expected IsBusinessTime(%s) to be %v", c.time, c.expected)
+		}
+	}
+}
+
+func TestBusinessHoursAddBusinessMinutes(t *testing.T) {
+	b, loc := newTestBusinessHours(t)
+
+	cases := []struct {
+		from     time.Time
+		minutes  int
+		expected time.Time
+	}{
+		{time.Date(2023, 12, 20, 10, 0, 0, 0, loc), 60, time.Date(2023, 12, 20, 11, 0, 0, 0, loc)},
+		{time.Date(2023, 12, 20, 16, 0, 0, 0, loc), 60, time.Date(2023, 12, 20, 17, 0, 0, 0, loc)},
+		{time.Date(2023, 12, 20, 16, 0, 0, 0, loc), 120, time.Date(2023, 12, 21, 10, 0, 0, 0, loc)},
+		// outside business hours counting starts at the next opening
+		{time.Date(2023, 12, 20, 20, 0, 0, 0, loc), 0, time.Date(2023, 12, 21, 9, 0, 0, 0, loc)},
+		// over the weekend and Christmas
+		{time.Date(2023, 12, 22, 16, 0, 0, 0, loc), 120, time.Date(2023, 12, 26, 10, 0, 0, 0, loc)},
+	}
+	for _, c := range cases {
+		actual, err := b.AddBusinessMinutes(c.from, c.minutes)
+		if err != nil {
+			t.Fatalf("Failed to add business minutes: %s", err)
+		}
+		if !actual.Equal(c.expected) {
+			t.Errorf("expected %s + %d business minutes to be %s, but got %s", c.from, c.minutes, c.expected, actual)
+		}
+	}
+
+	if _, err := b.AddBusinessMinutes(time.Now(), -1); err == nil {
+		t.Fatal("expected error for negative business minutes")
+	}
+}
+
+func TestBusinessHoursBusinessMinutesBetween(t *testing.T) {
+	b, loc := newTestBusinessHours(t)
+
+	cases := []struct {
+		from     time.Time
+		to       time.Time
+		expected int
+	}{
+		{time.Date(2023, 12, 20, 10, 0, 0, 0, loc), time.Date(2023, 12, 20, 11, 30, 0, 0, loc), 90},
+		{time.Date(2023, 12, 20, 16, 0, 0, 0, loc), time.Date(2023, 12, 21, 10, 0, 0, 0, loc), 120},
+		{time.Date(2023, 12, 22, 16, 0, 0, 0, loc), time.Date(2023, 12, 26, 10, 0, 0, 0, loc), 120},
+		{time.Date(2023, 12, 23, 10, 0, 0, 0, loc), time.Date(2023, 12, 24, 10, 0, 0, 0, loc), 0},
+		{time.Date(2023, 12, 20, 11, 30, 0, 0, loc), time.Date(2023, 12, 20, 10, 0, 0, 0, loc), -90},
+	}
+	for _, c := range cases {
+		if actual := b.BusinessMinutesBetween(c.from, c.to); actual != c.expected {
+			t.Errorf("expected %d business minutes between %s and %s, but got %d", c.expected, c.from, c.to, actual)
+		}
+	}
+}
+
+func TestBusinessHoursTimeDuration(t *testing.T) {
+	b, loc := newTestBusinessHours(t)
+
+	d := b.TimeDuration(time.Date(2023, 12, 20, 16, 0, 0, 0, loc), time.Date(2023, 12, 21, 10, 0, 0, 0, loc))
+	if d.Business != 120 || d.Calendar != 18*60 {
+		t.Fatalf("expected 120 business and 1080 calendar minutes, but got %v", d)
+	}
+}
+
+func TestBusinessHoursDaylightSavingTime(t *testing.T) {
+	b, loc := newTestBusinessHours(t)
+
+	// DST ends on 2023-11-05, business hours stay 9:00-17:00 in local time
+	from := time.Date(2023, 11, 3, 16, 0, 0, 0, loc)
+	to := time.Date(2023, 11, 6, 10, 0, 0, 0, loc)
+	if actual := b.BusinessMinutesBetween(from, to); actual != 120 {
+		t.Fatalf("expected 120 business minutes across DST change, but got %d", actual)
+	}
+}
+
+func TestNewBusinessHoursInvalid(t *testing.T) {
+	if _, err := NewBusinessHours(Schedule{TimeZone: "UTC"}, nil); err == nil {
+		t.Fatal("expected error for schedule without intervals")
+	}
+
+	schedule := Schedule{
+		TimeZone:  "UTC",
+		Intervals: []ScheduleInterval{NewScheduleInterval(time.Monday, 9, 0, 17, 0)},
+	}
+	if _, err := NewBusinessHours(schedule, []ScheduleHoliday{{Name: "x", StartDate: "12/25", EndDate: "12/25"}}); err == nil {
+		t.Fatal("expected error for invalid holiday date")
+	}
+	if _, err := NewBusinessHours(Schedule{TimeZone: "Nowhere/Invalid", Intervals: schedule.Intervals}, nil); err == nil {
+		t.Fatal("expected error for invalid time zone")
+	}
+}
@@ -1,5 +1,11 @@
 package zendesk
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // condition field types which are defined by system
 // https://developer.zendesk.com/rest_api/docs/core/triggers#conditions-reference
 const (
@@ -132,3 +138,402 @@ var conditionFieldText = map[int]string{
 func ConditionFieldText(fieldType int) string {
 	return conditionFieldText[fieldType]
 }
+
+// ConditionFieldFromText takes field name string and returns field type.
+// ok is false if the name is not a field defined by system.
+func ConditionFieldFromText(name string) (fieldType int, ok bool) {
+	for t, text := range conditionFieldText {
+		if text == name {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// Condition operators
+const (
+	ConditionOperatorIs             = "is"
+	ConditionOperatorIsNot          = "is_not"
+	ConditionOperatorLessThan       = "less_than"
+	ConditionOperatorGreaterThan    = "greater_than"
+	ConditionOperatorChanged        = "changed"
+	ConditionOperatorChangedTo      = "value"
+	ConditionOperatorChangedFrom    = "value_previous"
+	ConditionOperatorNotChanged     = "not_changed"
+	ConditionOperatorNotChangedTo   = "not_value"
+	ConditionOperatorNotChangedFrom = "not_value_previous"
+	ConditionOperatorIncludes       = "includes"
+	ConditionOperatorNotIncludes    = "not_includes"
+)
+
+var (
+	conditionOperatorsBasic      = []string{ConditionOperatorIs, ConditionOperatorIsNot}
+	conditionOperatorsChangeable = []string{
+		ConditionOperatorIs, ConditionOperatorIsNot,
+		ConditionOperatorChanged, ConditionOperatorChangedTo, ConditionOperatorChangedFrom,
+		ConditionOperatorNotChanged, ConditionOperatorNotChangedTo, ConditionOperatorNotChangedFrom,
+	}
+	conditionOperatorsOrdered = []string{
+		ConditionOperatorIs, ConditionOperatorIsNot, ConditionOperatorLessThan, ConditionOperatorGreaterThan,
+		ConditionOperatorChanged, ConditionOperatorChangedTo, ConditionOperatorChangedFrom,
+		ConditionOperatorNotChanged, ConditionOperatorNotChangedTo, ConditionOperatorNotChangedFrom,
+	}
+	conditionOperatorsTags   = []string{ConditionOperatorIncludes, ConditionOperatorNotIncludes}
+	conditionOperatorsText   = []string{ConditionOperatorIncludes, ConditionOperatorNotIncludes, ConditionOperatorIs, ConditionOperatorIsNot}
+	conditionOperatorsBool   = []string{ConditionOperatorIs}
+	conditionOperatorsNumber = []string{ConditionOperatorIs, ConditionOperatorLessThan, ConditionOperatorGreaterThan}
+)
+
+// conditionOperators is the operators each field defined by system accepts
+var conditionOperators = map[int][]string{
+	ConditionFieldGroupID:                        conditionOperatorsChangeable,
+	ConditionFieldAssigneeID:                     conditionOperatorsChangeable,
+	ConditionFieldRequesterID:                    conditionOperatorsChangeable,
+	ConditionFieldOrganizationID:                 conditionOperatorsChangeable,
+	ConditionFieldCurrentTags:                    conditionOperatorsTags,
+	ConditionFieldViaID:                          conditionOperatorsBasic,
+	ConditionFieldRecipient:                      conditionOperatorsBasic,
+	ConditionFieldType:                           conditionOperatorsChangeable,
+	ConditionFieldStatus:                         conditionOperatorsOrdered,
+	ConditionFieldPriority:                       conditionOperatorsOrdered,
+	ConditionFieldDescriptionIncludesWord:        conditionOperatorsText,
+	ConditionFieldLocaleID:                       conditionOperatorsChangeable,
+	ConditionFieldSatisfactionScore:              conditionOperatorsChangeable,
+	ConditionFieldSubjectIncludesWord:            conditionOperatorsText,
+	ConditionFieldCommentIncludesWord:            conditionOperatorsText,
+	ConditionFieldCurrentViaID:                   conditionOperatorsBasic,
+	ConditionFieldUpdateType:                     conditionOperatorsBool,
+	ConditionFieldCommentIsPublic:                conditionOperatorsBool,
+	ConditionFieldTicketIsPublic:                 conditionOperatorsBool,
+	ConditionFieldReopens:                        conditionOperatorsNumber,
+	ConditionFieldReplies:                        conditionOperatorsNumber,
+	ConditionFieldAgentStations:                  conditionOperatorsNumber,
+	ConditionFieldGroupStations:                  conditionOperatorsNumber,
+	ConditionFieldInBusinessHours:                conditionOperatorsBool,
+	ConditionFieldRequesterTwitterFollowersCount: conditionOperatorsNumber,
+	ConditionFieldRequesterTwitterStatusesCount:  conditionOperatorsNumber,
+	ConditionFieldRequesterTwitterVerified:       conditionOperatorsBool,
+	ConditionFieldTicketTypeID:                   conditionOperatorsChangeable,
+	ConditionFieldExactCreatedAt:                 conditionOperatorsNumber,
+	ConditionFieldNew:                            conditionOperatorsNumber,
+	ConditionFieldOpen:                           conditionOperatorsNumber,
+	ConditionFieldPending:                        conditionOperatorsNumber,
+	ConditionFieldSolved:                         conditionOperatorsNumber,
+	ConditionFieldClosed:                         conditionOperatorsNumber,
+	ConditionFieldAssignedAt:                     conditionOperatorsNumber,
+	ConditionFieldUpdatedAt:                      conditionOperatorsNumber,
+	ConditionFieldRequesterUpdatedAt:             conditionOperatorsNumber,
+	ConditionFieldAssigneeUpdatedAt:              conditionOperatorsNumber,
+	ConditionFieldDueDate:                        conditionOperatorsNumber,
+	ConditionFieldUntilDueDate:                   conditionOperatorsNumber,
+}
+
+// conditionValues is the values accepted by fields with a fixed set of values
+var conditionValues = map[int][]string{
+	ConditionFieldStatus:   {"new", "open", "pending", "hold", "solved", "closed"},
+	ConditionFieldPriority: {"low", "normal", "high", "urgent"},
+	ConditionFieldType:     {"question", "incident", "problem", "task"},
+}
+
+// Condition is a condition of business rules built with Cond.
+// It can be converted into the condition type of triggers, automations, views and SLA policies.
+type Condition struct {
+	Field    string
+	Operator string
+	Value    string
+}
+
+// Validate checks the operator and value are valid for the field.
+// Fields which are not defined as constants, such as brand_id or role, are not checked
+// as their operators depend on the resource, while custom fields take the operators of changeable fields.
+func (c Condition) Validate() error {
+	fieldType, known := ConditionFieldFromText(c.Field)
+	if !known {
+		if strings.HasPrefix(c.Field, "custom_fields_") && !containsString(conditionOperatorsChangeable, c.Operator) {
+			return fmt.Errorf("invalid operator for condition field %s: %s", c.Field, c.Operator)
+		}
+		return nil
+	}
+
+	if !containsString(conditionOperators[fieldType], c.Operator) {
+		return fmt.Errorf("invalid operator for condition field %s: %s", c.Field, c.Operator)
+	}
+
+	values, ok := conditionValues[fieldType]
+	if ok && c.Value != "" && !containsString(values, c.Value) {
+		return fmt.Errorf("invalid value for condition field %s: %s", c.Field, c.Value)
+	}
+	return nil
+}
+
+// TriggerCondition converts the condition into a trigger condition
+func (c Condition) TriggerCondition() TriggerCondition {
+	return TriggerCondition{Field: c.Field, Operator: c.Operator, Value: c.Value}
+}
+
+// AutomationCondition converts the condition into an automation condition
+func (c Condition) AutomationCondition() AutomationCondition {
+	return AutomationCondition{Field: c.Field, Operator: c.Operator, Value: c.Value}
+}
+
+// ViewCondition converts the condition into a view condition
+func (c Condition) ViewCondition() ViewCondition {
+	return ViewCondition{Field: c.Field, Operator: c.Operator, Value: c.Value}
+}
+
+// SLAPolicyFilter converts the condition into a filter of SLA policy
+func (c Condition) SLAPolicyFilter() SLAPolicyFilter {
+	return SLAPolicyFilter{Field: c.Field, Operator: c.Operator, Value: c.Value}
+}
+
+// ConditionField builds conditions of fields which only accept is and is_not
+type ConditionField struct {
+	name string
+}
+
+// Name returns the field name, e.g. "group_id"
+func (f ConditionField) Name() string {
+	return f.name
+}
+
+// Is builds a condition which matches if the field is the value
+func (f ConditionField) Is(value string) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorIs, Value: value}
+}
+
+// IsNot builds a condition which matches if the field is not the value
+func (f ConditionField) IsNot(value string) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorIsNot, Value: value}
+}
+
+// ChangeableConditionField builds conditions of fields whose changes can be tested by triggers
+type ChangeableConditionField struct {
+	ConditionField
+}
+
+// Changed builds a condition which matches if the field changed in the update
+func (f ChangeableConditionField) Changed() Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorChanged}
+}
+
+// ChangedTo builds a condition which matches if the field changed to the value in the update
+func (f ChangeableConditionField) ChangedTo(value string) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorChangedTo, Value: value}
+}
+
+// ChangedFrom builds a condition which matches if the field changed from the value in the update
+func (f ChangeableConditionField) ChangedFrom(value string) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorChangedFrom, Value: value}
+}
+
+// NotChanged builds a condition which matches if the field did not change in the update
+func (f ChangeableConditionField) NotChanged() Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorNotChanged}
+}
+
+// NotChangedTo builds a condition which matches if the field did not change to the value in the update
+func (f ChangeableConditionField) NotChangedTo(value string) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorNotChangedTo, Value: value}
+}
+
+// NotChangedFrom builds a condition which matches if the field did not change from the value in the update
+func (f ChangeableConditionField) NotChangedFrom(value string) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorNotChangedFrom, Value: value}
+}
+
+// OrderedConditionField builds conditions of fields whose values are ordered, i.e. status and priority
+type OrderedConditionField struct {
+	ChangeableConditionField
+}
+
+// LessThan builds a condition which matches if the field is before the value
+func (f OrderedConditionField) LessThan(value string) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorLessThan, Value: value}
+}
+
+// GreaterThan builds a condition which matches if the field is after the value
+func (f OrderedConditionField) GreaterThan(value string) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorGreaterThan, Value: value}
+}
+
+// TagsConditionField builds conditions of tags
+type TagsConditionField struct {
+	name string
+}
+
+// Includes builds a condition which matches if at least one of the tags is set
+func (f TagsConditionField) Includes(tags ...string) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorIncludes, Value: strings.Join(tags, " ")}
+}
+
+// NotIncludes builds a condition which matches if none of the tags is set
+func (f TagsConditionField) NotIncludes(tags ...string) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorNotIncludes, Value: strings.Join(tags, " ")}
+}
+
+// TextConditionField builds conditions of words in text such as subject and comments
+type TextConditionField struct {
+	ConditionField
+}
+
+// Includes builds a condition which matches if the text includes at least one of the words
+func (f TextConditionField) Includes(words ...string) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorIncludes, Value: strings.Join(words, " ")}
+}
+
+// NotIncludes builds a condition which matches if the text includes none of the words
+func (f TextConditionField) NotIncludes(words ...string) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorNotIncludes, Value: strings.Join(words, " ")}
+}
+
+// BoolConditionField builds conditions of fields which are tested with is only
+type BoolConditionField struct {
+	name string
+}
+
+// Is builds a condition which matches if the field is the value,
+// e.g. "true" or "false", or "Create" or "Change" for update_type
+func (f BoolConditionField) Is(value string) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorIs, Value: value}
+}
+
+// IsTrue builds a condition which matches if the field is true
+func (f BoolConditionField) IsTrue() Condition {
+	return f.Is("true")
+}
+
+// IsFalse builds a condition which matches if the field is false
+func (f BoolConditionField) IsFalse() Condition {
+	return f.Is("false")
+}
+
+// NumberConditionField builds conditions of counts and of hours since an event
+type NumberConditionField struct {
+	name string
+}
+
+// Is builds a condition which matches if the field is n
+func (f NumberConditionField) Is(n int) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorIs, Value: strconv.Itoa(n)}
+}
+
+// LessThan builds a condition which matches if the field is less than n
+func (f NumberConditionField) LessThan(n int) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorLessThan, Value: strconv.Itoa(n)}
+}
+
+// GreaterThan builds a condition which matches if the field is greater than n
+func (f NumberConditionField) GreaterThan(n int) Condition {
+	return Condition{Field: f.name, Operator: ConditionOperatorGreaterThan, Value: strconv.Itoa(n)}
+}
+
+// ConditionBuilder has a builder for each condition field defined by system.
+// Use Cond to build conditions, e.g. Cond.Status.Is("open").
+type ConditionBuilder struct {
+	GroupID                        ChangeableConditionField
+	AssigneeID                     ChangeableConditionField
+	RequesterID                    ChangeableConditionField
+	OrganizationID                 ChangeableConditionField
+	CurrentTags                    TagsConditionField
+	ViaID                          ConditionField
+	Recipient                      ConditionField
+	Type                           ChangeableConditionField
+	Status                         OrderedConditionField
+	Priority                       OrderedConditionField
+	DescriptionIncludesWord        TextConditionField
+	LocaleID                       ChangeableConditionField
+	SatisfactionScore              ChangeableConditionField
+	SubjectIncludesWord            TextConditionField
+	CommentIncludesWord            TextConditionField
+	CurrentViaID                   ConditionField
+	UpdateType                     BoolConditionField
+	CommentIsPublic                BoolConditionField
+	TicketIsPublic                 BoolConditionField
+	Reopens                        NumberConditionField
+	Replies                        NumberConditionField
+	AgentStations                  NumberConditionField
+	GroupStations                  NumberConditionField
+	InBusinessHours                BoolConditionField
+	RequesterTwitterFollowersCount NumberConditionField
+	RequesterTwitterStatusesCount  NumberConditionField
+	RequesterTwitterVerified       BoolConditionField
+	TicketTypeID                   ChangeableConditionField
+	ExactCreatedAt                 NumberConditionField
+
+	// Hours since the ticket entered the status, used by automations
+	New     NumberConditionField
+	Open    NumberConditionField
+	Pending NumberConditionField
+	Solved  NumberConditionField
+	Closed  NumberConditionField
+
+	// Hours since the event, used by automations
+	AssignedAt         NumberConditionField
+	UpdatedAt          NumberConditionField
+	RequesterUpdatedAt NumberConditionField
+	AssigneeUpdatedAt  NumberConditionField
+	DueDate            NumberConditionField
+	UntilDueDate       NumberConditionField
+}
+
+// CustomField returns the builder of a custom ticket field
+func (ConditionBuilder) CustomField(id int64) ChangeableConditionField {
+	return changeableConditionField(fmt.Sprintf("custom_fields_%d", id))
+}
+
+func changeableConditionField(name string) ChangeableConditionField {
+	return ChangeableConditionField{ConditionField{name: name}}
+}
+
+// Cond builds conditions of business rules
+var Cond = ConditionBuilder{
+	GroupID:                        changeableConditionField(ConditionFieldText(ConditionFieldGroupID)),
+	AssigneeID:                     changeableConditionField(ConditionFieldText(ConditionFieldAssigneeID)),
+	RequesterID:                    changeableConditionField(ConditionFieldText(ConditionFieldRequesterID)),
+	OrganizationID:                 changeableConditionField(ConditionFieldText(ConditionFieldOrganizationID)),
+	CurrentTags:                    TagsConditionField{ConditionFieldText(ConditionFieldCurrentTags)},
+	ViaID:                          ConditionField{ConditionFieldText(ConditionFieldViaID)},
+	Recipient:                      ConditionField{ConditionFieldText(ConditionFieldRecipient)},
+	Type:                           changeableConditionField(ConditionFieldText(ConditionFieldType)),
+	Status:                         OrderedConditionField{changeableConditionField(ConditionFieldText(ConditionFieldStatus))},
+	Priority:                       OrderedConditionField{changeableConditionField(ConditionFieldText(ConditionFieldPriority))},
+	DescriptionIncludesWord:        TextConditionField{ConditionField{ConditionFieldText(ConditionFieldDescriptionIncludesWord)}},
+	LocaleID:                       changeableConditionField(ConditionFieldText(ConditionFieldLocaleID)),
+	SatisfactionScore:              changeableConditionField(ConditionFieldText(ConditionFieldSatisfactionScore)),
+	SubjectIncludesWord:            TextConditionField{ConditionField{ConditionFieldText(ConditionFieldSubjectIncludesWord)}},
+	CommentIncludesWord:            TextConditionField{ConditionField{ConditionFieldText(ConditionFieldCommentIncludesWord)}},
+	CurrentViaID:                   ConditionField{ConditionFieldText(ConditionFieldCurrentViaID)},
+	UpdateType:                     BoolConditionField{ConditionFieldText(ConditionFieldUpdateType)},
+	CommentIsPublic:                BoolConditionField{ConditionFieldText(ConditionFieldCommentIsPublic)},
+	TicketIsPublic:                 BoolConditionField{ConditionFieldText(ConditionFieldTicketIsPublic)},
+	Reopens:                        NumberConditionField{ConditionFieldText(ConditionFieldReopens)},
+	Replies:                        NumberConditionField{ConditionFieldText(ConditionFieldReplies)},
+	AgentStations:                  NumberConditionField{ConditionFieldText(ConditionFieldAgentStations)},
+	GroupStations:                  NumberConditionField{ConditionFieldText(ConditionFieldGroupStations)},
+	InBusinessHours:                BoolConditionField{ConditionFieldText(ConditionFieldInBusinessHours)},
+	RequesterTwitterFollowersCount: NumberConditionField{ConditionFieldText(ConditionFieldRequesterTwitterFollowersCount)},
+	RequesterTwitterStatusesCount:  NumberConditionField{ConditionFieldText(ConditionFieldRequesterTwitterStatusesCount)},
+	RequesterTwitterVerified:       BoolConditionField{ConditionFieldText(ConditionFieldRequesterTwitterVerified)},
+	TicketTypeID:                   changeableConditionField(ConditionFieldText(ConditionFieldTicketTypeID)),
+	ExactCreatedAt:                 NumberConditionField{ConditionFieldText(ConditionFieldExactCreatedAt)},
+	New:                            NumberConditionField{ConditionFieldText(ConditionFieldNew)},
+	Open:                           NumberConditionField{ConditionFieldText(ConditionFieldOpen)},
+	Pending:                        NumberConditionField{ConditionFieldText(ConditionFieldPending)},
+	Solved:                         NumberConditionField{ConditionFieldText(ConditionFieldSolved)},
+	Closed:                         NumberConditionField{ConditionFieldText(ConditionFieldClosed)},
+	AssignedAt:                     NumberConditionField{ConditionFieldText(ConditionFieldAssignedAt)},
+	UpdatedAt:                      NumberConditionField{ConditionFieldText(ConditionFieldUpdatedAt)},
+	RequesterUpdatedAt:             NumberConditionField{ConditionFieldText(ConditionFieldRequesterUpdatedAt)},
+	AssigneeUpdatedAt:              NumberConditionField{ConditionFieldText(ConditionFieldAssigneeUpdatedAt)},
+	DueDate:                        NumberConditionField{ConditionFieldText(ConditionFieldDueDate)},
+	UntilDueDate:                   NumberConditionField{ConditionFieldText(ConditionFieldUntilDueDate)},
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -7,3 +7,96 @@ func TestConditionFieldText(t *testing.T) {
 		t.Fatal(`expected "group_id", but got ` + cond)
 	}
 }
+
+func TestConditionFieldFromText(t *testing.T) {
+	for fieldType := ConditionFieldGroupID; fieldType <= ConditionFieldUntilDueDate; fieldType++ {
+		name := ConditionFieldText(fieldType)
+		if name == "" {
+			t.Fatalf("field type %d has no name", fieldType)
+		}
+		if got, ok := ConditionFieldFromText(name); !ok || got != fieldType {
+			t.Fatalf("expected %d for %s, but got %d", fieldType, name, got)
+		}
+		if _, ok := conditionOperators[fieldType]; !ok {
+			t.Fatalf("field %s has no operators", name)
+		}
+	}
+
+	if _, ok := ConditionFieldFromText("unknown"); ok {
+		t.Fatal("expected unknown field not to be found")
+	}
+}
+
+func TestCond(t *testing.T) {
+	cases := []struct {
+		cond     Condition
+		expected Condition
+	}{
+		{Cond.Status.Is("open"), Condition{"status", "is", "open"}},
+		{Cond.Status.LessThan("solved"), Condition{"status", "less_than", "solved"}},
+		{Cond.Priority.ChangedTo("urgent"), Condition{"priority", "value", "urgent"}},
+		{Cond.AssigneeID.Changed(), Condition{"assignee_id", "changed", ""}},
+		{Cond.GroupID.NotChangedFrom("1"), Condition{"group_id", "not_value_previous", "1"}},
+		{Cond.CurrentTags.Includes("vip", "urgent"), Condition{"current_tags", "includes", "vip urgent"}},
+		{Cond.SubjectIncludesWord.NotIncludes("spam"), Condition{"subject_includes_word", "not_includes", "spam"}},
+		{Cond.CommentIsPublic.IsTrue(), Condition{"comment_is_public", "is", "true"}},
+		{Cond.UpdateType.Is("Create"), Condition{"update_type", "is", "Create"}},
+		{Cond.Pending.GreaterThan(24), Condition{"PENDING", "greater_than", "24"}},
+		{Cond.CustomField(360001).IsNot("foo"), Condition{"custom_fields_360001", "is_not", "foo"}},
+	}
+
+	for _, c := range cases {
+		if c.cond != c.expected {
+			t.Fatalf("expected %v, but got %v", c.expected, c.cond)
+		}
+		if err := c.cond.Validate(); err != nil {
+			t.Fatalf("failed to validate %v: %s", c.cond, err)
+		}
+	}
+}
+
+func TestConditionValidate(t *testing.T) {
+	// fields which are not defined as constants are not checked
+	valid := []Condition{
+		{Field: "brand_id", Operator: "is", Value: "1"},
+		{Field: "ticket_form_id", Operator: "is_not", Value: "2"},
+		{Field: "role", Operator: "is", Value: "end_user"},
+		{Field: "custom_status_id", Operator: "includes", Value: "3"},
+	}
+
+	for _, cond := range valid {
+		if err := cond.Validate(); err != nil {
+			t.Fatalf("failed to validate %v: %s", cond, err)
+		}
+	}
+
+	invalid := []Condition{
+		{Field: "custom_fields_360001", Operator: "includes", Value: "x"},
+		{Field: "current_tags", Operator: "is", Value: "vip"},
+		{Field: "reopens", Operator: "changed"},
+		{Field: "status", Operator: "is", Value: "unknown"},
+	}
+
+	for _, cond := range invalid {
+		if err := cond.Validate(); err == nil {
+			t.Fatalf("expected %v to be invalid", cond)
+		}
+	}
+}
+
+func TestConditionConversion(t *testing.T) {
+	cond := Cond.Status.Is("open")
+
+	if c := cond.TriggerCondition(); c.Field != "status" || c.Operator != "is" || c.Value != "open" {
+		t.Fatalf("unexpected trigger condition: %v", c)
+	}
+	if c := cond.AutomationCondition(); c != (AutomationCondition{Field: "status", Operator: "is", Value: "open"}) {
+		t.Fatalf("unexpected automation condition: %v", c)
+	}
+	if c := cond.ViewCondition(); c.Field != "status" || c.Operator != "is" || c.Value != "open" {
+		t.Fatalf("unexpected view condition: %v", c)
+	}
+	if c := cond.SLAPolicyFilter(); c.Field != "status" || c.Operator != "is" || c.Value != "open" {
+		t.Fatalf("unexpected SLA policy filter: %v", c)
+	}
+}
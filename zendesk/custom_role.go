@@ -3,28 +3,218 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 )
 
-// Configuration is a dictionary of custom configuration fields
-type Configuration map[string]interface{}
+// Configuration is the permissions block of a custom role.
+// Permissions which are nil or empty are omitted, so that an update only changes the permissions which are set.
+// Permissions returned by Zendesk which are not declared as fields are kept in Other
+// and sent back as is, so that a read-modify-write does not lose them.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/custom_roles/#configuration
+type Configuration struct {
+	AssignTicketsToAnyGroup      *bool `json:"assign_tickets_to_any_group,omitempty"`
+	ChatAccess                   *bool `json:"chat_access,omitempty"`
+	ForumAccessRestrictedContent *bool `json:"forum_access_restricted_content,omitempty"`
+	GroupAccess                  *bool `json:"group_access,omitempty"`
+	LightAgent                   *bool `json:"light_agent,omitempty"`
+	ManageBusinessRules          *bool `json:"manage_business_rules,omitempty"`
+	ManageContextualWorkspaces   *bool `json:"manage_contextual_workspaces,omitempty"`
+	ManageDynamicContent         *bool `json:"manage_dynamic_content,omitempty"`
+	ManageExtensionsAndChannels  *bool `json:"manage_extensions_and_channels,omitempty"`
+	ManageFacebook               *bool `json:"manage_facebook,omitempty"`
+	ManageOrganizationFields     *bool `json:"manage_organization_fields,omitempty"`
+	ManageTicketFields           *bool `json:"manage_ticket_fields,omitempty"`
+	ManageTicketForms            *bool `json:"manage_ticket_forms,omitempty"`
+	ManageUserFields             *bool `json:"manage_user_fields,omitempty"`
+	ModerateForums               *bool `json:"moderate_forums,omitempty"`
+	OrganizationEditing          *bool `json:"organization_editing,omitempty"`
+	OrganizationNotesEditing     *bool `json:"organization_notes_editing,omitempty"`
+	SideConversationCreate       *bool `json:"side_conversation_create,omitempty"`
+	TicketDeletion               *bool `json:"ticket_deletion,omitempty"`
+	TicketEditing                *bool `json:"ticket_editing,omitempty"`
+	TicketMerge                  *bool `json:"ticket_merge,omitempty"`
+	TicketTagEditing             *bool `json:"ticket_tag_editing,omitempty"`
+	TwitterSearchAccess          *bool `json:"twitter_search_access,omitempty"`
+	ViewDeletedTickets           *bool `json:"view_deleted_tickets,omitempty"`
+	VoiceAccess                  *bool `json:"voice_access,omitempty"`
+	VoiceDashboardAccess         *bool `json:"voice_dashboard_access,omitempty"`
+
+	// EndUserListAccess can take "full" or "none"
+	EndUserListAccess string `json:"end_user_list_access,omitempty"`
+
+	// EndUserProfileAccess can take "edit", "edit-within-org", "full" or "readonly"
+	EndUserProfileAccess string `json:"end_user_profile_access,omitempty"`
+
+	// ExploreAccess can take "edit", "full", "none" or "readonly"
+	ExploreAccess string `json:"explore_access,omitempty"`
+
+	// ForumAccess can take "edit-topics", "full" or "readonly"
+	ForumAccess string `json:"forum_access,omitempty"`
+
+	// MacroAccess can take "full", "manage-group", "manage-personal" or "readonly"
+	MacroAccess string `json:"macro_access,omitempty"`
+
+	// ReportAccess can take "full", "none" or "readonly"
+	ReportAccess string `json:"report_access,omitempty"`
+
+	// TicketAccess can take "all", "assigned-only", "within-groups",
+	// "within-groups-and-public-groups" or "within-organization"
+	TicketAccess string `json:"ticket_access,omitempty"`
+
+	// TicketCommentAccess can take "none" or "public"
+	TicketCommentAccess string `json:"ticket_comment_access,omitempty"`
+
+	// UserViewAccess can take "full", "manage-group", "manage-personal", "none" or "readonly"
+	UserViewAccess string `json:"user_view_access,omitempty"`
+
+	// ViewAccess can take "full", "manage-group", "manage-personal", "playonly" or "readonly"
+	ViewAccess string `json:"view_access,omitempty"`
+
+	// Other holds the permissions which are not declared as fields, keyed by the name in the API
+	Other map[string]interface{} `json:"-"`
+}
+
+// configurationFields is Configuration without the custom marshaller
+type configurationFields Configuration
+
+// configurationKeys is the names in the API of the permissions declared as fields of Configuration,
+// in the order of the fields. Other must stay the last field of Configuration.
+var configurationKeys = func() []string {
+	var keys []string
+	t := reflect.TypeOf(Configuration{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "-" {
+			keys = append(keys, name)
+		}
+	}
+	return keys
+}()
+
+// MarshalJSON is marshaller for Configuration which sends the permissions in Other as well
+func (c Configuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.permissions())
+}
+
+// UnmarshalJSON is unmarshaller for Configuration which keeps the permissions not declared as fields in Other
+func (c *Configuration) UnmarshalJSON(data []byte) error {
+	var fields configurationFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var other map[string]interface{}
+	if err := json.Unmarshal(data, &other); err != nil {
+		return err
+	}
+	for _, key := range configurationKeys {
+		delete(other, key)
+	}
+	if len(other) == 0 {
+		other = nil
+	}
+
+	*c = Configuration(fields)
+	c.Other = other
+	return nil
+}
+
+// permissions returns the permissions which are set, keyed by the name in the API
+func (c Configuration) permissions() map[string]interface{} {
+	permissions := map[string]interface{}{}
+	for key, value := range c.Other {
+		permissions[key] = value
+	}
+
+	v := reflect.ValueOf(c)
+	for i, key := range configurationKeys {
+		switch field := v.Field(i); field.Kind() {
+		case reflect.Ptr:
+			if !field.IsNil() {
+				permissions[key] = field.Elem().Interface()
+			}
+		case reflect.String:
+			if field.String() != "" {
+				permissions[key] = field.String()
+			}
+		}
+	}
+	return permissions
+}
 
 // CustomRole is zendesk CustomRole JSON payload format
 // https://developer.zendesk.com/api-reference/ticketing/account-configuration/custom_roles/
 type CustomRole struct {
 	Description     string        `json:"description,omitempty"`
 	ID              int64         `json:"id,omitempty"`
-	TeamMemberCount int64         `json:"team_member_count"`
-	Name            string        `json:"name"`
+	TeamMemberCount int64         `json:"team_member_count,omitempty"`
+	Name            string        `json:"name,omitempty"`
 	Configuration   Configuration `json:"configuration"`
-	RoleType        int64         `json:"role_type"`
+	RoleType        int64         `json:"role_type,omitempty"`
 	CreatedAt       time.Time     `json:"created_at,omitempty"`
 	UpdatedAt       time.Time     `json:"updated_at,omitempty"`
 }
 
+// CustomRolePermissionChange is a permission which differs between two custom roles.
+// Permission is the name of the permission in the API, e.g. "ticket_access".
+// From or To is nil if the permission is not set in the role.
+type CustomRolePermissionChange struct {
+	Permission string
+	From       interface{}
+	To         interface{}
+}
+
+// String returns the change in the form of "ticket_access: within-groups -> all"
+func (c CustomRolePermissionChange) String() string {
+	return fmt.Sprintf("%s: %v -> %v", c.Permission, c.From, c.To)
+}
+
+// DiffCustomRolePermissions returns the permissions which differ between from and to,
+// including the permissions in Configuration.Other.
+// Permissions declared as fields come first in the order they are declared,
+// followed by the other permissions in alphabetical order.
+func DiffCustomRolePermissions(from, to CustomRole) []CustomRolePermissionChange {
+	fromPermissions := from.Configuration.permissions()
+	toPermissions := to.Configuration.permissions()
+
+	var other []string
+	for _, permissions := range []map[string]interface{}{fromPermissions, toPermissions} {
+		for key := range permissions {
+			if !containsString(configurationKeys, key) && !containsString(other, key) {
+				other = append(other, key)
+			}
+		}
+	}
+	sort.Strings(other)
+
+	var changes []CustomRolePermissionChange
+	for _, key := range append(append([]string{}, configurationKeys...), other...) {
+		f, t := fromPermissions[key], toPermissions[key]
+		if reflect.DeepEqual(f, t) {
+			continue
+		}
+
+		changes = append(changes, CustomRolePermissionChange{
+			Permission: key,
+			From:       f,
+			To:         t,
+		})
+	}
+	return changes
+}
+
 // CustomRoleAPI an interface containing all CustomRole related methods
 type CustomRoleAPI interface {
 	GetCustomRoles(ctx context.Context) ([]CustomRole, error)
+	GetCustomRole(ctx context.Context, roleID int64) (CustomRole, error)
+	CreateCustomRole(ctx context.Context, role CustomRole) (CustomRole, error)
+	UpdateCustomRole(ctx context.Context, roleID int64, role CustomRole) (CustomRole, error)
+	DeleteCustomRole(ctx context.Context, roleID int64) error
 }
 
 // GetRoles fetch CustomRoles list
@@ -47,3 +237,68 @@ func (z *Client) GetCustomRoles(ctx context.Context) ([]CustomRole, error) {
 	}
 	return data.CustomRoles, nil
 }
+
+// GetCustomRole gets a specified custom role
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/custom_roles/#show-custom-role
+func (z *Client) GetCustomRole(ctx context.Context, roleID int64) (CustomRole, error) {
+	var result struct {
+		CustomRole CustomRole `json:"custom_role"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/custom_roles/%d.json", roleID))
+	if err != nil {
+		return CustomRole{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomRole{}, err
+	}
+	return result.CustomRole, nil
+}
+
+// CreateCustomRole creates new custom role
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/custom_roles/#create-custom-role
+func (z *Client) CreateCustomRole(ctx context.Context, role CustomRole) (CustomRole, error) {
+	var data, result struct {
+		CustomRole CustomRole `json:"custom_role"`
+	}
+	data.CustomRole = role
+
+	body, err := z.post(ctx, "/custom_roles.json", data)
+	if err != nil {
+		return CustomRole{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomRole{}, err
+	}
+	return result.CustomRole, nil
+}
+
+// UpdateCustomRole updates a specified custom role
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/custom_roles/#update-custom-role
+func (z *Client) UpdateCustomRole(ctx context.Context, roleID int64, role CustomRole) (CustomRole, error) {
+	var data, result struct {
+		CustomRole CustomRole `json:"custom_role"`
+	}
+	data.CustomRole = role
+
+	body, err := z.put(ctx, fmt.Sprintf("/custom_roles/%d.json", roleID), data)
+	if err != nil {
+		return CustomRole{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomRole{}, err
+	}
+	return result.CustomRole, nil
+}
+
+// DeleteCustomRole deletes a specified custom role
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/custom_roles/#delete-custom-role
+func (z *Client) DeleteCustomRole(ctx context.Context, roleID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/custom_roles/%d.json", roleID))
+}
@@ -0,0 +1,189 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGetCustomRoles(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_roles.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	roles, err := client.GetCustomRoles(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get custom roles: %s", err)
+	}
+
+	if len(roles) != 2 {
+		t.Fatalf("expected length of custom roles is 2, but got %d", len(roles))
+	}
+	if roles[1].Configuration.MacroAccess != "manage-personal" {
+		t.Fatalf("expected macro access is manage-personal, but got %s", roles[1].Configuration.MacroAccess)
+	}
+}
+
+func TestGetCustomRole(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_role.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	role, err := client.GetCustomRole(ctx, 10127)
+	if err != nil {
+		t.Fatalf("Failed to get custom role: %s", err)
+	}
+
+	if role.ID != 10127 || !*role.Configuration.ManageBusinessRules {
+		t.Fatalf("unexpected custom role %v", role)
+	}
+
+	// permissions which are not declared as fields are kept and sent back
+	if role.Configuration.Other["manage_skills"] != true {
+		t.Fatalf("expected manage_skills in other permissions, but got %v", role.Configuration.Other)
+	}
+	body, err := json.Marshal(role.Configuration)
+	if err != nil {
+		t.Fatalf("Failed to marshal configuration: %s", err)
+	}
+	var config map[string]interface{}
+	if err := json.Unmarshal(body, &config); err != nil {
+		t.Fatalf("Failed to unmarshal configuration: %s", err)
+	}
+	if config["manage_skills"] != true || config["ticket_deletion"] != false || len(config) != 32 {
+		t.Fatalf("unexpected configuration %s", body)
+	}
+}
+
+func TestCreateCustomRole(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			CustomRole map[string]json.RawMessage `json:"custom_role"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+
+		var config map[string]interface{}
+		if err := json.Unmarshal(data.CustomRole["configuration"], &config); err != nil {
+			t.Fatalf("Failed to unmarshal configuration: %s", err)
+		}
+		if config["ticket_deletion"] != false || config["ticket_access"] != "within-groups" {
+			t.Fatalf("unexpected configuration: %s", data.CustomRole["configuration"])
+		}
+		for _, key := range []string{"report_access", "chat_access"} {
+			if _, ok := config[key]; ok {
+				t.Fatalf("expected %s which is not set to be omitted", key)
+			}
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "custom_role.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	editing, deletion := true, false
+	role, err := client.CreateCustomRole(ctx, CustomRole{
+		Name: "Escalations",
+		Configuration: Configuration{
+			TicketEditing:  &editing,
+			TicketDeletion: &deletion,
+			TicketAccess:   "within-groups",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create custom role: %s", err)
+	}
+
+	if role.ID != 10129 {
+		t.Fatalf("expected id of custom role is 10129, but got %d", role.ID)
+	}
+}
+
+func TestUpdateCustomRole(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			CustomRole map[string]json.RawMessage `json:"custom_role"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		for _, key := range []string{"name", "role_type"} {
+			if _, ok := data.CustomRole[key]; ok {
+				t.Fatalf("expected %s which is not set to be omitted, but got %s", key, body)
+			}
+		}
+		if string(data.CustomRole["configuration"]) != `{"macro_access":"full"}` {
+			t.Fatalf("expected only the permission which is set to be sent, but got %s", body)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "custom_role.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	role, err := client.UpdateCustomRole(ctx, 10127, CustomRole{Configuration: Configuration{MacroAccess: "full"}})
+	if err != nil {
+		t.Fatalf("Failed to update custom role: %s", err)
+	}
+
+	if role.Configuration.TicketAccess != "all" {
+		t.Fatalf("expected ticket access is all, but got %s", role.Configuration.TicketAccess)
+	}
+}
+
+func TestDeleteCustomRole(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteCustomRole(ctx, 10127)
+	if err != nil {
+		t.Fatalf("Failed to delete custom role: %s", err)
+	}
+}
+
+func TestDiffCustomRolePermissions(t *testing.T) {
+	yes, no := true, false
+	from := CustomRole{Configuration: Configuration{
+		ChatAccess:    &yes,
+		TicketEditing: &yes,
+		TicketAccess:  "within-groups",
+		Other:         map[string]interface{}{"manage_skills": false},
+	}}
+	to := CustomRole{Configuration: Configuration{
+		ChatAccess:    &yes,
+		TicketEditing: &no,
+		TicketAccess:  "all",
+		ViewAccess:    "full",
+		Other:         map[string]interface{}{"manage_skills": true, "custom_objects": "full"},
+	}}
+
+	changes := DiffCustomRolePermissions(from, to)
+	expected := []CustomRolePermissionChange{
+		{Permission: "ticket_editing", From: true, To: false},
+		{Permission: "ticket_access", From: "within-groups", To: "all"},
+		{Permission: "view_access", From: nil, To: "full"},
+		{Permission: "custom_objects", From: nil, To: "full"},
+		{Permission: "manage_skills", From: false, To: true},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Fatalf("expected %v, but got %v", expected, changes)
+	}
+
+	if s := changes[1].String(); s != "ticket_access: within-groups -> all" {
+		t.Fatalf("unexpected string %s", s)
+	}
+
+	if changes := DiffCustomRolePermissions(from, from); len(changes) != 0 {
+		t.Fatalf("expected no changes, but got %v", changes)
+	}
+}
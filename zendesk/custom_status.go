@@ -0,0 +1,200 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Status categories of CustomStatus.
+// They are the same as the built-in ticket statuses.
+const (
+	CustomStatusCategoryNew     = "new"
+	CustomStatusCategoryOpen    = "open"
+	CustomStatusCategoryPending = "pending"
+	CustomStatusCategoryHold    = "hold"
+	CustomStatusCategorySolved  = "solved"
+)
+
+// CustomStatus is struct for custom ticket status payload.
+// Active and Default are pointers so that a status can be deactivated with an explicit false,
+// as custom statuses cannot be deleted.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/
+type CustomStatus struct {
+	ID                    int64      `json:"id,omitempty"`
+	URL                   string     `json:"url,omitempty"`
+	StatusCategory        string     `json:"status_category,omitempty"`
+	AgentLabel            string     `json:"agent_label,omitempty"`
+	RawAgentLabel         string     `json:"raw_agent_label,omitempty"`
+	EndUserLabel          string     `json:"end_user_label,omitempty"`
+	RawEndUserLabel       string     `json:"raw_end_user_label,omitempty"`
+	Description           string     `json:"description,omitempty"`
+	RawDescription        string     `json:"raw_description,omitempty"`
+	EndUserDescription    string     `json:"end_user_description,omitempty"`
+	RawEndUserDescription string     `json:"raw_end_user_description,omitempty"`
+	Active                *bool      `json:"active,omitempty"`
+	Default               *bool      `json:"default,omitempty"`
+	CreatedAt             *time.Time `json:"created_at,omitempty"`
+	UpdatedAt             *time.Time `json:"updated_at,omitempty"`
+}
+
+// CustomStatusListOptions is options for GetCustomStatuses
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#list-custom-ticket-statuses
+type CustomStatusListOptions struct {
+	// StatusCategories is a comma separated list of status categories, e.g. "open,pending"
+	StatusCategories string `url:"status_categories,omitempty"`
+
+	// Active and Default filter the statuses only when true
+	Active  bool `url:"active,omitempty"`
+	Default bool `url:"default,omitempty"`
+}
+
+// CustomStatusIndex maps custom status IDs to custom statuses.
+// It is used to resolve the status category of tickets without fetching each status.
+type CustomStatusIndex map[int64]CustomStatus
+
+// NewCustomStatusIndex creates an index of the given custom statuses
+func NewCustomStatusIndex(statuses []CustomStatus) CustomStatusIndex {
+	index := make(CustomStatusIndex, len(statuses))
+	for _, status := range statuses {
+		index[status.ID] = status
+	}
+	return index
+}
+
+// Category returns the status category of the custom status.
+// It returns false if the custom status is not in the index.
+func (i CustomStatusIndex) Category(customStatusID int64) (string, bool) {
+	status, ok := i[customStatusID]
+	if !ok {
+		return "", false
+	}
+	return status.StatusCategory, true
+}
+
+// TicketCategory returns the status category of the ticket.
+// It falls back to Ticket.Status if the custom status of the ticket is unknown,
+// e.g. on accounts without custom statuses.
+func (i CustomStatusIndex) TicketCategory(ticket Ticket) string {
+	if category, ok := i.Category(ticket.CustomStatusID); ok {
+		return category
+	}
+	return ticket.Status
+}
+
+// CustomStatusAPI an interface containing all custom ticket status related methods
+type CustomStatusAPI interface {
+	GetCustomStatuses(ctx context.Context, opts *CustomStatusListOptions) ([]CustomStatus, error)
+	GetCustomStatus(ctx context.Context, id int64) (CustomStatus, error)
+	CreateCustomStatus(ctx context.Context, status CustomStatus) (CustomStatus, error)
+	UpdateCustomStatus(ctx context.Context, id int64, status CustomStatus) (CustomStatus, error)
+	SetDefaultCustomStatuses(ctx context.Context, ids []int64) error
+}
+
+// GetCustomStatuses fetch custom ticket status list
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#list-custom-ticket-statuses
+func (z *Client) GetCustomStatuses(ctx context.Context, opts *CustomStatusListOptions) ([]CustomStatus, error) {
+	var result struct {
+		CustomStatuses []CustomStatus `json:"custom_statuses"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CustomStatusListOptions{}
+	}
+
+	u, err := addOptions("/custom_statuses.json", tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.CustomStatuses, nil
+}
+
+// GetCustomStatus gets a specified custom ticket status
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#show-custom-ticket-status
+func (z *Client) GetCustomStatus(ctx context.Context, id int64) (CustomStatus, error) {
+	var result struct {
+		CustomStatus CustomStatus `json:"custom_status"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/custom_statuses/%d.json", id))
+	if err != nil {
+		return CustomStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+	return result.CustomStatus, nil
+}
+
+// CreateCustomStatus creates a new custom ticket status
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#create-custom-ticket-status
+func (z *Client) CreateCustomStatus(ctx context.Context, status CustomStatus) (CustomStatus, error) {
+	var data, result struct {
+		CustomStatus CustomStatus `json:"custom_status"`
+	}
+	data.CustomStatus = status
+
+	body, err := z.post(ctx, "/custom_statuses.json", data)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+	return result.CustomStatus, nil
+}
+
+// UpdateCustomStatus updates a specified custom ticket status
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#update-custom-ticket-status
+func (z *Client) UpdateCustomStatus(ctx context.Context, id int64, status CustomStatus) (CustomStatus, error) {
+	var data, result struct {
+		CustomStatus CustomStatus `json:"custom_status"`
+	}
+	data.CustomStatus = status
+
+	body, err := z.put(ctx, fmt.Sprintf("/custom_statuses/%d.json", id), data)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+	return result.CustomStatus, nil
+}
+
+// SetDefaultCustomStatuses sets the given custom statuses as the defaults of their status categories
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#bulk-update-default-custom-ticket-status
+func (z *Client) SetDefaultCustomStatuses(ctx context.Context, ids []int64) error {
+	var data struct {
+		IDs string `json:"ids"`
+	}
+	data.IDs = joinIDs(ids)
+
+	_, err := z.put(ctx, "/custom_status/default.json", data)
+	return err
+}
@@ -0,0 +1,129 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetCustomStatuses(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if categories := r.URL.Query().Get("status_categories"); categories != "open,pending" {
+			t.Fatalf("unexpected status categories %s", categories)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "custom_statuses.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	statuses, err := client.GetCustomStatuses(ctx, &CustomStatusListOptions{StatusCategories: "open,pending"})
+	if err != nil {
+		t.Fatalf("Failed to get custom statuses: %s", err)
+	}
+
+	if len(statuses) != 2 {
+		t.Fatalf("expected length of custom statuses is 2, but got %d", len(statuses))
+	}
+}
+
+func TestGetCustomStatus(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.GetCustomStatus(ctx, 1234577)
+	if err != nil {
+		t.Fatalf("Failed to get custom status: %s", err)
+	}
+
+	if status.StatusCategory != CustomStatusCategoryPending {
+		t.Fatalf("expected status category is pending, but got %s", status.StatusCategory)
+	}
+}
+
+func TestCreateCustomStatus(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "custom_status.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.CreateCustomStatus(ctx, CustomStatus{
+		StatusCategory: CustomStatusCategoryPending,
+		AgentLabel:     "Waiting on vendor",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create custom status: %s", err)
+	}
+
+	if status.ID != 1234577 {
+		t.Fatalf("expected id of custom status is 1234577, but got %d", status.ID)
+	}
+}
+
+func TestUpdateCustomStatus(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "custom_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateCustomStatus(ctx, 1234577, CustomStatus{Description: "Waiting for a reply from a third party"})
+	if err != nil {
+		t.Fatalf("Failed to update custom status: %s", err)
+	}
+}
+
+func TestDeactivateCustomStatus(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || string(body) != `{"custom_status":{"active":false}}` {
+			t.Fatalf("unexpected request %s: %s", r.Method, body)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "custom_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	active := false
+	_, err := client.UpdateCustomStatus(ctx, 1234577, CustomStatus{Active: &active})
+	if err != nil {
+		t.Fatalf("Failed to deactivate custom status: %s", err)
+	}
+}
+
+func TestSetDefaultCustomStatuses(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path != "/custom_status/default.json" || string(body) != `{"ids":"1234567,1234577"}` {
+			t.Fatalf("unexpected request %s: %s", r.URL.Path, body)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.SetDefaultCustomStatuses(ctx, []int64{1234567, 1234577})
+	if err != nil {
+		t.Fatalf("Failed to set default custom statuses: %s", err)
+	}
+}
+
+func TestCustomStatusIndex(t *testing.T) {
+	index := NewCustomStatusIndex([]CustomStatus{
+		{ID: 1, StatusCategory: CustomStatusCategoryOpen},
+		{ID: 2, StatusCategory: CustomStatusCategoryPending},
+	})
+
+	if category, ok := index.Category(2); !ok || category != CustomStatusCategoryPending {
+		t.Fatalf("expected category pending, but got %s", category)
+	}
+	if _, ok := index.Category(3); ok {
+		t.Fatal("expected unknown custom status not to be found")
+	}
+
+	if category := index.TicketCategory(Ticket{CustomStatusID: 1, Status: "pending"}); category != CustomStatusCategoryOpen {
+		t.Fatalf("expected category open, but got %s", category)
+	}
+	if category := index.TicketCategory(Ticket{Status: "solved"}); category != CustomStatusCategorySolved {
+		t.Fatalf("expected fallback to ticket status, but got %s", category)
+	}
+}
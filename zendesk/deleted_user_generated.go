@@ -0,0 +1,74 @@
+
+// Code generated by Script. DO NOT EDIT.
+// Source: script/codegen/main.go
+//
+// Generated by this command:
+//
+//	go run script/codegen/main.go
+
+package zendesk
+
+import "context"
+
+func (z *Client) GetDeletedUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User] {
+	return &Iterator[User]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         opts.IsCBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc:       z.GetDeletedUsersOBP,
+		cbpFunc:       z.GetDeletedUsersCBP,
+	}
+}
+
+func (z *Client) GetDeletedUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error) {
+	var data struct {
+		Users []User `json:"deleted_users"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OBPOptions{}
+	}
+	
+	u, err := addOptions("/deleted_users.json", tmp)
+	
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Users, data.Page, nil
+}
+
+func (z *Client) GetDeletedUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, CursorPaginationMeta, error) {
+	var data struct {
+		Users []User `json:"deleted_users"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+	
+	u, err := addOptions("/deleted_users.json", tmp)
+	
+	if err != nil {
+		return nil, data.Meta, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	return data.Users, data.Meta, nil
+}
+
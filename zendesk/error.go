@@ -6,6 +6,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 )
 
 // Error an error type containing the http response from zendesk
@@ -57,3 +58,13 @@ type OptionsError struct {
 func (e *OptionsError) Error() string {
 	return fmt.Sprintf("invalid options: %v", e.opts)
 }
+
+// SLAPolicyValidationError is an error type for SLA policy filter
+// which is not valid for the definitions of the account.
+type SLAPolicyValidationError struct {
+	Errors []string
+}
+
+func (e *SLAPolicyValidationError) Error() string {
+	return fmt.Sprintf("invalid SLA policy: %s", strings.Join(e.Errors, "; "))
+}
@@ -37,6 +37,7 @@ type GroupAPI interface {
 	CreateGroup(ctx context.Context, group Group) (Group, error)
 	UpdateGroup(ctx context.Context, groupID int64, group Group) (Group, error)
 	DeleteGroup(ctx context.Context, groupID int64) error
+	GetAssignableGroups(ctx context.Context, opts *GroupListOptions) ([]Group, Page, error)
 }
 
 // GetGroups fetches group list
@@ -143,3 +144,33 @@ func (z *Client) DeleteGroup(ctx context.Context, groupID int64) error {
 
 	return nil
 }
+
+// GetAssignableGroups fetches groups which tickets can be assigned to
+// https://developer.zendesk.com/api-reference/ticketing/groups/groups/#list-assignable-groups
+func (z *Client) GetAssignableGroups(ctx context.Context, opts *GroupListOptions) ([]Group, Page, error) {
+	var data struct {
+		Groups []Group `json:"groups"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &GroupListOptions{}
+	}
+
+	u, err := addOptions("/groups/assignable.json", tmp)
+	if err != nil {
+		return []Group{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return []Group{}, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return []Group{}, Page{}, err
+	}
+	return data.Groups, data.Page, nil
+}
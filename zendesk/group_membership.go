@@ -3,9 +3,14 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
+// GroupMembershipBatchLimit is the maximum number of memberships accepted by
+// the group membership bulk endpoints at once
+const GroupMembershipBatchLimit = 100
+
 type (
 	// GroupMembership is struct for group membership payload
 	// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/
@@ -28,12 +33,26 @@ type (
 		UserID  int64 `json:"user_id,omitempty" url:"user_id,omitempty"`
 	}
 
+	// GroupMembershipOptions is a struct for options for group membership
+	// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/
+	GroupMembershipOptions struct {
+		GroupID int64 `json:"group_id,omitempty"`
+		UserID  int64 `json:"user_id,omitempty"`
+	}
+
 	// GroupMembershipAPI is an interface containing group membership related methods
 	GroupMembershipAPI interface {
 		GetGroupMemberships(context.Context, *GroupMembershipListOptions) ([]GroupMembership, Page, error)
 		GetGroupMembershipsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[GroupMembership]
 		GetGroupMembershipsOBP(ctx context.Context, opts *OBPOptions) ([]GroupMembership, Page, error)
 		GetGroupMembershipsCBP(ctx context.Context, opts *CBPOptions) ([]GroupMembership, CursorPaginationMeta, error)
+		GetGroupMembership(context.Context, int64) (GroupMembership, error)
+		CreateGroupMembership(context.Context, GroupMembershipOptions) (GroupMembership, error)
+		DeleteGroupMembership(context.Context, int64) error
+		CreateManyGroupMemberships(context.Context, []GroupMembershipOptions) ([]JobStatus, error)
+		DestroyManyGroupMemberships(context.Context, []int64) ([]JobStatus, error)
+		SetDefaultGroupMembership(ctx context.Context, userID, membershipID int64) ([]GroupMembership, error)
+		GetAssignableGroupMemberships(ctx context.Context, groupID int64, opts *PageOptions) ([]GroupMembership, Page, error)
 	}
 )
 
@@ -66,3 +85,123 @@ func (z *Client) GetGroupMemberships(ctx context.Context, opts *GroupMembershipL
 
 	return result.GroupMemberships, result.Page, nil
 }
+
+// GetGroupMembership gets a specified group membership
+// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#show-membership
+func (z *Client) GetGroupMembership(ctx context.Context, id int64) (GroupMembership, error) {
+	var result struct {
+		GroupMembership GroupMembership `json:"group_membership"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/group_memberships/%d.json", id))
+	if err != nil {
+		return GroupMembership{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return GroupMembership{}, err
+	}
+
+	return result.GroupMembership, nil
+}
+
+// CreateGroupMembership assigns an agent to a group
+// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#create-membership
+func (z *Client) CreateGroupMembership(ctx context.Context, opts GroupMembershipOptions) (GroupMembership, error) {
+	var data struct {
+		GroupMembership GroupMembershipOptions `json:"group_membership"`
+	}
+	var result struct {
+		GroupMembership GroupMembership `json:"group_membership"`
+	}
+
+	data.GroupMembership = opts
+
+	body, err := z.post(ctx, "/group_memberships.json", data)
+	if err != nil {
+		return GroupMembership{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return GroupMembership{}, err
+	}
+
+	return result.GroupMembership, nil
+}
+
+// DeleteGroupMembership removes an agent from a group
+// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#delete-membership
+func (z *Client) DeleteGroupMembership(ctx context.Context, id int64) error {
+	return z.delete(ctx, fmt.Sprintf("/group_memberships/%d.json", id))
+}
+
+// CreateManyGroupMemberships assigns agents to groups in the background.
+// Memberships are sent in chunks of GroupMembershipBatchLimit and a job status is returned for each chunk.
+// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#bulk-create-memberships
+func (z *Client) CreateManyGroupMemberships(ctx context.Context, opts []GroupMembershipOptions) ([]JobStatus, error) {
+	return chunkJobs(opts, GroupMembershipBatchLimit, func(opts []GroupMembershipOptions) (JobStatus, error) {
+		var data struct {
+			GroupMemberships []GroupMembershipOptions `json:"group_memberships"`
+		}
+		data.GroupMemberships = opts
+		return z.postJobStatus(ctx, "/group_memberships/create_many.json", data)
+	})
+}
+
+// DestroyManyGroupMemberships removes agents from groups in the background.
+// IDs are sent in chunks of GroupMembershipBatchLimit and a job status is returned for each chunk.
+// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#bulk-delete-memberships
+func (z *Client) DestroyManyGroupMemberships(ctx context.Context, ids []int64) ([]JobStatus, error) {
+	return chunkJobs(ids, GroupMembershipBatchLimit, func(ids []int64) (JobStatus, error) {
+		return z.deleteJobStatus(ctx, fmt.Sprintf("/group_memberships/destroy_many.json?ids=%s", joinIDs(ids)))
+	})
+}
+
+// SetDefaultGroupMembership sets the default group of an agent and returns all memberships of the agent
+// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#set-membership-as-default
+func (z *Client) SetDefaultGroupMembership(ctx context.Context, userID, membershipID int64) ([]GroupMembership, error) {
+	var result struct {
+		GroupMemberships []GroupMembership `json:"group_memberships"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d/group_memberships/%d/make_default.json", userID, membershipID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+
+	return result.GroupMemberships, nil
+}
+
+// GetAssignableGroupMemberships gets the memberships of the group which tickets can be assigned to
+// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#list-assignable-memberships
+func (z *Client) GetAssignableGroupMemberships(ctx context.Context, groupID int64, opts *PageOptions) ([]GroupMembership, Page, error) {
+	var result struct {
+		GroupMemberships []GroupMembership `json:"group_memberships"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = new(PageOptions)
+	}
+
+	u, err := addOptions(fmt.Sprintf("/groups/%d/memberships/assignable.json", groupID), tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Page{}, err
+	}
+
+	return result.GroupMemberships, result.Page, nil
+}
@@ -1,7 +1,11 @@
 package zendesk
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -19,3 +23,135 @@ func TestGetGroupMemberships(t *testing.T) {
 		t.Fatalf("expected length of group memberships is 2, but got %d", len(groupMemberships))
 	}
 }
+
+func TestGetGroupMembership(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "group_membership.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	groupMembership, err := client.GetGroupMembership(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to get group membership: %s", err)
+	}
+
+	if groupMembership.ID != 360002440594 {
+		t.Fatalf("expected id of group membership is 360002440594, but got %d", groupMembership.ID)
+	}
+}
+
+func TestCreateGroupMembership(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"group_membership":{"group_id":98907558,"user_id":15439980}}`
+		if string(body) != expected {
+			t.Fatalf("expected request body %s, but got %s", expected, body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "group_membership.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateGroupMembership(ctx, GroupMembershipOptions{GroupID: 98907558, UserID: 15439980})
+	if err != nil {
+		t.Fatalf("Failed to create group membership: %s", err)
+	}
+}
+
+func TestDeleteGroupMembership(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/group_memberships/360002440594.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteGroupMembership(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to delete group membership: %s", err)
+	}
+}
+
+func TestCreateManyGroupMemberships(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			GroupMemberships []GroupMembershipOptions `json:"group_memberships"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		if len(data.GroupMemberships) != 2 {
+			t.Fatalf("unexpected request body: %s", body)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateManyGroupMemberships(ctx, []GroupMembershipOptions{
+		{GroupID: 1, UserID: 10},
+		{GroupID: 2, UserID: 10},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create many group memberships: %s", err)
+	}
+}
+
+func TestDestroyManyGroupMemberships(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Query().Get("ids") != "1,2" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.DestroyManyGroupMemberships(ctx, []int64{1, 2})
+	if err != nil {
+		t.Fatalf("Failed to destroy many group memberships: %s", err)
+	}
+}
+
+func TestSetDefaultGroupMembership(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/15439980/group_memberships/360002440594/make_default.json" {
+			t.Fatalf("unexpected request path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "group_memberships_make_default.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	groupMemberships, err := client.SetDefaultGroupMembership(ctx, 15439980, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to set default group membership: %s", err)
+	}
+
+	if len(groupMemberships) != 2 || !groupMemberships[0].Default {
+		t.Fatalf("unexpected group memberships %v", groupMemberships)
+	}
+}
+
+func TestGetAssignableGroupMemberships(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/98907558/memberships/assignable.json" {
+			t.Fatalf("unexpected request path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "group_memberships.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	groupMemberships, _, err := client.GetAssignableGroupMemberships(ctx, 98907558, nil)
+	if err != nil {
+		t.Fatalf("Failed to get assignable group memberships: %s", err)
+	}
+
+	if len(groupMemberships) != 2 {
+		t.Fatalf("expected length of group memberships is 2, but got %d", len(groupMemberships))
+	}
+}
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -133,3 +134,23 @@ func TestDeleteGroup(t *testing.T) {
 		t.Fatalf("Failed to delete group: %s", err)
 	}
 }
+
+func TestGetAssignableGroups(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/assignable.json" {
+			t.Fatalf("unexpected request path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "groups.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	groups, _, err := client.GetAssignableGroups(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get assignable groups: %s", err)
+	}
+
+	if len(groups) == 0 {
+		t.Fatal("expected assignable groups, but got none")
+	}
+}
@@ -50,6 +50,12 @@ type CommonOptions struct {
 	CategoryID        string `url:"category_id,omitempty"`
 
 	IncludeInlineImages string `url:"include_inline_images,omitempty"`
+
+	Status    string `url:"status,omitempty"`
+	Score     string `url:"score,omitempty"`
+	StartTime int64  `url:"start_time,omitempty"`
+	EndTime   int64  `url:"end_time,omitempty"`
+	Since     string `url:"since,omitempty"`
 }
 
 // CBPOptions struct is used to specify options for listing objects in CBP (Cursor Based Pagination).
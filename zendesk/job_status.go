@@ -0,0 +1,140 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Statuses of JobStatus
+const (
+	JobStatusQueued    = "queued"
+	JobStatusWorking   = "working"
+	JobStatusFailed    = "failed"
+	JobStatusCompleted = "completed"
+	JobStatusKilled    = "killed"
+)
+
+// JobStatusResult is the result of a single item processed by a background job
+type JobStatusResult struct {
+	ID         int64  `json:"id,omitempty"`
+	Index      int    `json:"index,omitempty"`
+	Action     string `json:"action,omitempty"`
+	Success    bool   `json:"success,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Error      string `json:"error,omitempty"`
+	Errors     string `json:"errors,omitempty"`
+	Details    string `json:"details,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+	Email      string `json:"email,omitempty"`
+}
+
+// JobStatus is struct for job status payload.
+// Bulk endpoints are processed in the background and return a job status
+// which can be polled with GetJobStatus.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/
+type JobStatus struct {
+	ID       string            `json:"id,omitempty"`
+	URL      string            `json:"url,omitempty"`
+	Total    int               `json:"total,omitempty"`
+	Progress int               `json:"progress,omitempty"`
+	Status   string            `json:"status,omitempty"`
+	Message  string            `json:"message,omitempty"`
+	Results  []JobStatusResult `json:"results,omitempty"`
+}
+
+// Done returns true if the job is no longer queued or running
+func (j JobStatus) Done() bool {
+	switch j.Status {
+	case JobStatusFailed, JobStatusCompleted, JobStatusKilled:
+		return true
+	}
+	return false
+}
+
+// JobStatusAPI an interface containing all job status related methods
+type JobStatusAPI interface {
+	GetJobStatus(ctx context.Context, id string) (JobStatus, error)
+	GetJobStatuses(ctx context.Context, ids []string) ([]JobStatus, error)
+}
+
+// GetJobStatus gets a specified job status
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#show-job-status
+func (z *Client) GetJobStatus(ctx context.Context, id string) (JobStatus, error) {
+	var result struct {
+		JobStatus JobStatus `json:"job_status"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/job_statuses/%s.json", id))
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return result.JobStatus, nil
+}
+
+// GetJobStatuses gets multiple job statuses
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#show-many-job-statuses
+func (z *Client) GetJobStatuses(ctx context.Context, ids []string) ([]JobStatus, error) {
+	var result struct {
+		JobStatuses []JobStatus `json:"job_statuses"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/job_statuses/show_many.json?ids=%s", strings.Join(ids, ",")))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.JobStatuses, nil
+}
+
+// postJobStatus posts data and returns the job status of the response
+func (z *Client) postJobStatus(ctx context.Context, path string, data interface{}) (JobStatus, error) {
+	body, err := z.post(ctx, path, data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return unmarshalJobStatus(body)
+}
+
+// putJobStatus puts data and returns the job status of the response
+func (z *Client) putJobStatus(ctx context.Context, path string, data interface{}) (JobStatus, error) {
+	body, err := z.put(ctx, path, data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return unmarshalJobStatus(body)
+}
+
+// deleteJobStatus sends delete request and returns the job status of the response
+func (z *Client) deleteJobStatus(ctx context.Context, path string) (JobStatus, error) {
+	body, err := z.deleteWithBody(ctx, path)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return unmarshalJobStatus(body)
+}
+
+func unmarshalJobStatus(body []byte) (JobStatus, error) {
+	var result struct {
+		JobStatus JobStatus `json:"job_status"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return result.JobStatus, nil
+}
@@ -0,0 +1,42 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetJobStatus(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobStatus, err := client.GetJobStatus(ctx, "8b726e606741012ffc2d782bcb7848fe")
+	if err != nil {
+		t.Fatalf("Failed to get job status: %s", err)
+	}
+
+	if !jobStatus.Done() {
+		t.Fatalf("expected job to be done, but status is %s", jobStatus.Status)
+	}
+	if len(jobStatus.Results) != 2 {
+		t.Fatalf("expected length of results is 2, but got %d", len(jobStatus.Results))
+	}
+}
+
+func TestGetJobStatuses(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "job_statuses.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobStatuses, err := client.GetJobStatuses(ctx, []string{"8b726e606741012ffc2d782bcb7848fe", "e7665094164c498781ebe4c8db6d2af5"})
+	if err != nil {
+		t.Fatalf("Failed to get job statuses: %s", err)
+	}
+
+	if len(jobStatuses) != 2 {
+		t.Fatalf("expected length of job statuses is 2, but got %d", len(jobStatuses))
+	}
+	if jobStatuses[1].Done() {
+		t.Fatal("expected queued job not to be done")
+	}
+}
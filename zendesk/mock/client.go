@@ -10,6 +10,7 @@ package mock
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	zendesk "github.com/nukosuke/go-zendesk/zendesk"
@@ -84,6 +85,36 @@ func (mr *ClientMockRecorder) AddUserTags(ctx, userID, tags any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserTags", reflect.TypeOf((*Client)(nil).AddUserTags), ctx, userID, tags)
 }
 
+// AutocompleteOrganizations mocks base method.
+func (m *Client) AutocompleteOrganizations(ctx context.Context, name string) ([]zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutocompleteOrganizations", ctx, name)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AutocompleteOrganizations indicates an expected call of AutocompleteOrganizations.
+func (mr *ClientMockRecorder) AutocompleteOrganizations(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutocompleteOrganizations", reflect.TypeOf((*Client)(nil).AutocompleteOrganizations), ctx, name)
+}
+
+// AutocompleteProblems mocks base method.
+func (m *Client) AutocompleteProblems(ctx context.Context, text string) ([]zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutocompleteProblems", ctx, text)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AutocompleteProblems indicates an expected call of AutocompleteProblems.
+func (mr *ClientMockRecorder) AutocompleteProblems(ctx, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutocompleteProblems", reflect.TypeOf((*Client)(nil).AutocompleteProblems), ctx, text)
+}
+
 // AutocompleteSearchCustomObjectRecords mocks base method.
 func (m *Client) AutocompleteSearchCustomObjectRecords(ctx context.Context, customObjectKey string, opts *zendesk.CustomObjectAutocompleteOptions) ([]zendesk.CustomObjectRecord, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +131,65 @@ func (mr *ClientMockRecorder) AutocompleteSearchCustomObjectRecords(ctx, customO
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutocompleteSearchCustomObjectRecords", reflect.TypeOf((*Client)(nil).AutocompleteSearchCustomObjectRecords), ctx, customObjectKey, opts)
 }
 
+// AutocompleteUsers mocks base method.
+func (m *Client) AutocompleteUsers(ctx context.Context, name string) ([]zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutocompleteUsers", ctx, name)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AutocompleteUsers indicates an expected call of AutocompleteUsers.
+func (mr *ClientMockRecorder) AutocompleteUsers(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutocompleteUsers", reflect.TypeOf((*Client)(nil).AutocompleteUsers), ctx, name)
+}
+
+// BatchUpdateManyOrganizations mocks base method.
+func (m *Client) BatchUpdateManyOrganizations(ctx context.Context, orgs []zendesk.OrganizationUpdate) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateManyOrganizations", ctx, orgs)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchUpdateManyOrganizations indicates an expected call of BatchUpdateManyOrganizations.
+func (mr *ClientMockRecorder) BatchUpdateManyOrganizations(ctx, orgs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateManyOrganizations", reflect.TypeOf((*Client)(nil).BatchUpdateManyOrganizations), ctx, orgs)
+}
+
+// BatchUpdateManyUsers mocks base method.
+func (m *Client) BatchUpdateManyUsers(ctx context.Context, users []zendesk.UserUpdate) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateManyUsers", ctx, users)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchUpdateManyUsers indicates an expected call of BatchUpdateManyUsers.
+func (mr *ClientMockRecorder) BatchUpdateManyUsers(ctx, users any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateManyUsers", reflect.TypeOf((*Client)(nil).BatchUpdateManyUsers), ctx, users)
+}
+
+// ChangeUserPassword mocks base method.
+func (m *Client) ChangeUserPassword(ctx context.Context, userID int64, previousPassword, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeUserPassword", ctx, userID, previousPassword, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeUserPassword indicates an expected call of ChangeUserPassword.
+func (mr *ClientMockRecorder) ChangeUserPassword(ctx, userID, previousPassword, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUserPassword", reflect.TypeOf((*Client)(nil).ChangeUserPassword), ctx, userID, previousPassword, password)
+}
+
 // CreateAutomation mocks base method.
 func (m *Client) CreateAutomation(ctx context.Context, automation zendesk.Automation) (zendesk.Automation, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +235,36 @@ func (mr *ClientMockRecorder) CreateCustomObjectRecord(ctx, record, customObject
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomObjectRecord", reflect.TypeOf((*Client)(nil).CreateCustomObjectRecord), ctx, record, customObjectKey)
 }
 
+// CreateCustomRole mocks base method.
+func (m *Client) CreateCustomRole(ctx context.Context, role zendesk.CustomRole) (zendesk.CustomRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomRole", ctx, role)
+	ret0, _ := ret[0].(zendesk.CustomRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomRole indicates an expected call of CreateCustomRole.
+func (mr *ClientMockRecorder) CreateCustomRole(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRole", reflect.TypeOf((*Client)(nil).CreateCustomRole), ctx, role)
+}
+
+// CreateCustomStatus mocks base method.
+func (m *Client) CreateCustomStatus(ctx context.Context, status zendesk.CustomStatus) (zendesk.CustomStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomStatus", ctx, status)
+	ret0, _ := ret[0].(zendesk.CustomStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomStatus indicates an expected call of CreateCustomStatus.
+func (mr *ClientMockRecorder) CreateCustomStatus(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomStatus", reflect.TypeOf((*Client)(nil).CreateCustomStatus), ctx, status)
+}
+
 // CreateDynamicContentItem mocks base method.
 func (m *Client) CreateDynamicContentItem(ctx context.Context, item zendesk.DynamicContentItem) (zendesk.DynamicContentItem, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +295,21 @@ func (mr *ClientMockRecorder) CreateGroup(ctx, group any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*Client)(nil).CreateGroup), ctx, group)
 }
 
+// CreateGroupMembership mocks base method.
+func (m *Client) CreateGroupMembership(arg0 context.Context, arg1 zendesk.GroupMembershipOptions) (zendesk.GroupMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupMembership", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.GroupMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupMembership indicates an expected call of CreateGroupMembership.
+func (mr *ClientMockRecorder) CreateGroupMembership(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupMembership", reflect.TypeOf((*Client)(nil).CreateGroupMembership), arg0, arg1)
+}
+
 // CreateMacro mocks base method.
 func (m *Client) CreateMacro(ctx context.Context, macro zendesk.Macro) (zendesk.Macro, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +325,96 @@ func (mr *ClientMockRecorder) CreateMacro(ctx, macro any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMacro", reflect.TypeOf((*Client)(nil).CreateMacro), ctx, macro)
 }
 
+// CreateManyGroupMemberships mocks base method.
+func (m *Client) CreateManyGroupMemberships(arg0 context.Context, arg1 []zendesk.GroupMembershipOptions) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateManyGroupMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateManyGroupMemberships indicates an expected call of CreateManyGroupMemberships.
+func (mr *ClientMockRecorder) CreateManyGroupMemberships(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyGroupMemberships", reflect.TypeOf((*Client)(nil).CreateManyGroupMemberships), arg0, arg1)
+}
+
+// CreateManyOrganizationMemberships mocks base method.
+func (m *Client) CreateManyOrganizationMemberships(arg0 context.Context, arg1 []zendesk.OrganizationMembershipOptions) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateManyOrganizationMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateManyOrganizationMemberships indicates an expected call of CreateManyOrganizationMemberships.
+func (mr *ClientMockRecorder) CreateManyOrganizationMemberships(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyOrganizationMemberships", reflect.TypeOf((*Client)(nil).CreateManyOrganizationMemberships), arg0, arg1)
+}
+
+// CreateManyOrganizations mocks base method.
+func (m *Client) CreateManyOrganizations(ctx context.Context, orgs []zendesk.Organization) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateManyOrganizations", ctx, orgs)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateManyOrganizations indicates an expected call of CreateManyOrganizations.
+func (mr *ClientMockRecorder) CreateManyOrganizations(ctx, orgs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyOrganizations", reflect.TypeOf((*Client)(nil).CreateManyOrganizations), ctx, orgs)
+}
+
+// CreateManyUsers mocks base method.
+func (m *Client) CreateManyUsers(ctx context.Context, users []zendesk.User) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateManyUsers", ctx, users)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateManyUsers indicates an expected call of CreateManyUsers.
+func (mr *ClientMockRecorder) CreateManyUsers(ctx, users any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyUsers", reflect.TypeOf((*Client)(nil).CreateManyUsers), ctx, users)
+}
+
+// CreateOrUpdateManyUsers mocks base method.
+func (m *Client) CreateOrUpdateManyUsers(ctx context.Context, users []zendesk.User) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateManyUsers", ctx, users)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateManyUsers indicates an expected call of CreateOrUpdateManyUsers.
+func (mr *ClientMockRecorder) CreateOrUpdateManyUsers(ctx, users any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateManyUsers", reflect.TypeOf((*Client)(nil).CreateOrUpdateManyUsers), ctx, users)
+}
+
+// CreateOrUpdateOrganization mocks base method.
+func (m *Client) CreateOrUpdateOrganization(ctx context.Context, org zendesk.OrganizationUpdate) (zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateOrganization", ctx, org)
+	ret0, _ := ret[0].(zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateOrganization indicates an expected call of CreateOrUpdateOrganization.
+func (mr *ClientMockRecorder) CreateOrUpdateOrganization(ctx, org any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateOrganization", reflect.TypeOf((*Client)(nil).CreateOrUpdateOrganization), ctx, org)
+}
+
 // CreateOrUpdateUser mocks base method.
 func (m *Client) CreateOrUpdateUser(ctx context.Context, user zendesk.User) (zendesk.User, error) {
 	m.ctrl.T.Helper()
@@ -250,6 +475,36 @@ func (mr *ClientMockRecorder) CreateOrganizationMembership(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationMembership", reflect.TypeOf((*Client)(nil).CreateOrganizationMembership), arg0, arg1)
 }
 
+// CreateOrganizationSubscription mocks base method.
+func (m *Client) CreateOrganizationSubscription(arg0 context.Context, arg1 zendesk.OrganizationSubscription) (zendesk.OrganizationSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationSubscription", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.OrganizationSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganizationSubscription indicates an expected call of CreateOrganizationSubscription.
+func (mr *ClientMockRecorder) CreateOrganizationSubscription(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationSubscription", reflect.TypeOf((*Client)(nil).CreateOrganizationSubscription), arg0, arg1)
+}
+
+// CreateRequest mocks base method.
+func (m *Client) CreateRequest(ctx context.Context, request zendesk.Request) (zendesk.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRequest", ctx, request)
+	ret0, _ := ret[0].(zendesk.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRequest indicates an expected call of CreateRequest.
+func (mr *ClientMockRecorder) CreateRequest(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequest", reflect.TypeOf((*Client)(nil).CreateRequest), ctx, request)
+}
+
 // CreateSLAPolicy mocks base method.
 func (m *Client) CreateSLAPolicy(ctx context.Context, slaPolicy zendesk.SLAPolicy) (zendesk.SLAPolicy, error) {
 	m.ctrl.T.Helper()
@@ -265,6 +520,81 @@ func (mr *ClientMockRecorder) CreateSLAPolicy(ctx, slaPolicy any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSLAPolicy", reflect.TypeOf((*Client)(nil).CreateSLAPolicy), ctx, slaPolicy)
 }
 
+// CreateSatisfactionRating mocks base method.
+func (m *Client) CreateSatisfactionRating(ctx context.Context, ticketID int64, rating zendesk.SatisfactionRating) (zendesk.SatisfactionRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSatisfactionRating", ctx, ticketID, rating)
+	ret0, _ := ret[0].(zendesk.SatisfactionRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSatisfactionRating indicates an expected call of CreateSatisfactionRating.
+func (mr *ClientMockRecorder) CreateSatisfactionRating(ctx, ticketID, rating any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSatisfactionRating", reflect.TypeOf((*Client)(nil).CreateSatisfactionRating), ctx, ticketID, rating)
+}
+
+// CreateSchedule mocks base method.
+func (m *Client) CreateSchedule(ctx context.Context, schedule zendesk.Schedule) (zendesk.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", ctx, schedule)
+	ret0, _ := ret[0].(zendesk.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *ClientMockRecorder) CreateSchedule(ctx, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*Client)(nil).CreateSchedule), ctx, schedule)
+}
+
+// CreateScheduleHoliday mocks base method.
+func (m *Client) CreateScheduleHoliday(ctx context.Context, scheduleID int64, holiday zendesk.ScheduleHoliday) (zendesk.ScheduleHoliday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduleHoliday", ctx, scheduleID, holiday)
+	ret0, _ := ret[0].(zendesk.ScheduleHoliday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduleHoliday indicates an expected call of CreateScheduleHoliday.
+func (mr *ClientMockRecorder) CreateScheduleHoliday(ctx, scheduleID, holiday any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduleHoliday", reflect.TypeOf((*Client)(nil).CreateScheduleHoliday), ctx, scheduleID, holiday)
+}
+
+// CreateSideConversation mocks base method.
+func (m *Client) CreateSideConversation(ctx context.Context, ticketID int64, message zendesk.SideConversationMessage) (zendesk.SideConversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSideConversation", ctx, ticketID, message)
+	ret0, _ := ret[0].(zendesk.SideConversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSideConversation indicates an expected call of CreateSideConversation.
+func (mr *ClientMockRecorder) CreateSideConversation(ctx, ticketID, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSideConversation", reflect.TypeOf((*Client)(nil).CreateSideConversation), ctx, ticketID, message)
+}
+
+// CreateSkip mocks base method.
+func (m *Client) CreateSkip(ctx context.Context, ticketID int64, reason string) (zendesk.Skip, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSkip", ctx, ticketID, reason)
+	ret0, _ := ret[0].(zendesk.Skip)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSkip indicates an expected call of CreateSkip.
+func (mr *ClientMockRecorder) CreateSkip(ctx, ticketID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSkip", reflect.TypeOf((*Client)(nil).CreateSkip), ctx, ticketID, reason)
+}
+
 // CreateTarget mocks base method.
 func (m *Client) CreateTarget(ctx context.Context, ticketField zendesk.Target) (zendesk.Target, error) {
 	m.ctrl.T.Helper()
@@ -385,6 +715,36 @@ func (mr *ClientMockRecorder) CreateUserField(ctx, userField any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserField", reflect.TypeOf((*Client)(nil).CreateUserField), ctx, userField)
 }
 
+// CreateUserIdentity mocks base method.
+func (m *Client) CreateUserIdentity(ctx context.Context, userID int64, identity zendesk.UserIdentity) (zendesk.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserIdentity", ctx, userID, identity)
+	ret0, _ := ret[0].(zendesk.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserIdentity indicates an expected call of CreateUserIdentity.
+func (mr *ClientMockRecorder) CreateUserIdentity(ctx, userID, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserIdentity", reflect.TypeOf((*Client)(nil).CreateUserIdentity), ctx, userID, identity)
+}
+
+// CreateView mocks base method.
+func (m *Client) CreateView(ctx context.Context, view zendesk.View) (zendesk.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateView", ctx, view)
+	ret0, _ := ret[0].(zendesk.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateView indicates an expected call of CreateView.
+func (mr *ClientMockRecorder) CreateView(ctx, view any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateView", reflect.TypeOf((*Client)(nil).CreateView), ctx, view)
+}
+
 // CreateWebhook mocks base method.
 func (m *Client) CreateWebhook(ctx context.Context, hook *zendesk.Webhook) (*zendesk.Webhook, error) {
 	m.ctrl.T.Helper()
@@ -442,6 +802,20 @@ func (mr *ClientMockRecorder) DeleteBrand(ctx, brandID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBrand", reflect.TypeOf((*Client)(nil).DeleteBrand), ctx, brandID)
 }
 
+// DeleteCustomRole mocks base method.
+func (m *Client) DeleteCustomRole(ctx context.Context, roleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomRole", ctx, roleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomRole indicates an expected call of DeleteCustomRole.
+func (mr *ClientMockRecorder) DeleteCustomRole(ctx, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRole", reflect.TypeOf((*Client)(nil).DeleteCustomRole), ctx, roleID)
+}
+
 // DeleteDynamicContentItem mocks base method.
 func (m *Client) DeleteDynamicContentItem(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
@@ -470,6 +844,20 @@ func (mr *ClientMockRecorder) DeleteGroup(ctx, groupID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*Client)(nil).DeleteGroup), ctx, groupID)
 }
 
+// DeleteGroupMembership mocks base method.
+func (m *Client) DeleteGroupMembership(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupMembership", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroupMembership indicates an expected call of DeleteGroupMembership.
+func (mr *ClientMockRecorder) DeleteGroupMembership(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupMembership", reflect.TypeOf((*Client)(nil).DeleteGroupMembership), arg0, arg1)
+}
+
 // DeleteMacro mocks base method.
 func (m *Client) DeleteMacro(ctx context.Context, macroID int64) error {
 	m.ctrl.T.Helper()
@@ -498,91 +886,175 @@ func (mr *ClientMockRecorder) DeleteOrganization(ctx, orgID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganization", reflect.TypeOf((*Client)(nil).DeleteOrganization), ctx, orgID)
 }
 
-// DeleteSLAPolicy mocks base method.
-func (m *Client) DeleteSLAPolicy(ctx context.Context, id int64) error {
+// DeleteOrganizationMembership mocks base method.
+func (m *Client) DeleteOrganizationMembership(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSLAPolicy", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteOrganizationMembership", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteSLAPolicy indicates an expected call of DeleteSLAPolicy.
-func (mr *ClientMockRecorder) DeleteSLAPolicy(ctx, id any) *gomock.Call {
+// DeleteOrganizationMembership indicates an expected call of DeleteOrganizationMembership.
+func (mr *ClientMockRecorder) DeleteOrganizationMembership(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSLAPolicy", reflect.TypeOf((*Client)(nil).DeleteSLAPolicy), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationMembership", reflect.TypeOf((*Client)(nil).DeleteOrganizationMembership), arg0, arg1)
 }
 
-// DeleteTarget mocks base method.
-func (m *Client) DeleteTarget(ctx context.Context, ticketID int64) error {
+// DeleteOrganizationSubscription mocks base method.
+func (m *Client) DeleteOrganizationSubscription(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTarget", ctx, ticketID)
+	ret := m.ctrl.Call(m, "DeleteOrganizationSubscription", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteTarget indicates an expected call of DeleteTarget.
-func (mr *ClientMockRecorder) DeleteTarget(ctx, ticketID any) *gomock.Call {
+// DeleteOrganizationSubscription indicates an expected call of DeleteOrganizationSubscription.
+func (mr *ClientMockRecorder) DeleteOrganizationSubscription(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTarget", reflect.TypeOf((*Client)(nil).DeleteTarget), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationSubscription", reflect.TypeOf((*Client)(nil).DeleteOrganizationSubscription), arg0, arg1)
 }
 
-// DeleteTicket mocks base method.
-func (m *Client) DeleteTicket(ctx context.Context, ticketID int64) error {
+// DeleteSLAPolicy mocks base method.
+func (m *Client) DeleteSLAPolicy(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTicket", ctx, ticketID)
+	ret := m.ctrl.Call(m, "DeleteSLAPolicy", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteTicket indicates an expected call of DeleteTicket.
-func (mr *ClientMockRecorder) DeleteTicket(ctx, ticketID any) *gomock.Call {
+// DeleteSLAPolicy indicates an expected call of DeleteSLAPolicy.
+func (mr *ClientMockRecorder) DeleteSLAPolicy(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicket", reflect.TypeOf((*Client)(nil).DeleteTicket), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSLAPolicy", reflect.TypeOf((*Client)(nil).DeleteSLAPolicy), ctx, id)
 }
 
-// DeleteTicketField mocks base method.
-func (m *Client) DeleteTicketField(ctx context.Context, ticketID int64) error {
+// DeleteSchedule mocks base method.
+func (m *Client) DeleteSchedule(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTicketField", ctx, ticketID)
+	ret := m.ctrl.Call(m, "DeleteSchedule", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteTicketField indicates an expected call of DeleteTicketField.
-func (mr *ClientMockRecorder) DeleteTicketField(ctx, ticketID any) *gomock.Call {
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *ClientMockRecorder) DeleteSchedule(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketField", reflect.TypeOf((*Client)(nil).DeleteTicketField), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*Client)(nil).DeleteSchedule), ctx, id)
 }
 
-// DeleteTicketForm mocks base method.
-func (m *Client) DeleteTicketForm(ctx context.Context, id int64) error {
+// DeleteScheduleHoliday mocks base method.
+func (m *Client) DeleteScheduleHoliday(ctx context.Context, scheduleID, holidayID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTicketForm", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteScheduleHoliday", ctx, scheduleID, holidayID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteTicketForm indicates an expected call of DeleteTicketForm.
-func (mr *ClientMockRecorder) DeleteTicketForm(ctx, id any) *gomock.Call {
+// DeleteScheduleHoliday indicates an expected call of DeleteScheduleHoliday.
+func (mr *ClientMockRecorder) DeleteScheduleHoliday(ctx, scheduleID, holidayID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketForm", reflect.TypeOf((*Client)(nil).DeleteTicketForm), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduleHoliday", reflect.TypeOf((*Client)(nil).DeleteScheduleHoliday), ctx, scheduleID, holidayID)
 }
 
-// DeleteTrigger mocks base method.
-func (m *Client) DeleteTrigger(ctx context.Context, id int64) error {
+// DeleteSuspendedTicket mocks base method.
+func (m *Client) DeleteSuspendedTicket(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTrigger", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteSuspendedTicket", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteTrigger indicates an expected call of DeleteTrigger.
-func (mr *ClientMockRecorder) DeleteTrigger(ctx, id any) *gomock.Call {
+// DeleteSuspendedTicket indicates an expected call of DeleteSuspendedTicket.
+func (mr *ClientMockRecorder) DeleteSuspendedTicket(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrigger", reflect.TypeOf((*Client)(nil).DeleteTrigger), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSuspendedTicket", reflect.TypeOf((*Client)(nil).DeleteSuspendedTicket), ctx, id)
 }
 
-// DeleteUpload mocks base method.
+// DeleteSuspendedTickets mocks base method.
+func (m *Client) DeleteSuspendedTickets(ctx context.Context, ids []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSuspendedTickets", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSuspendedTickets indicates an expected call of DeleteSuspendedTickets.
+func (mr *ClientMockRecorder) DeleteSuspendedTickets(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSuspendedTickets", reflect.TypeOf((*Client)(nil).DeleteSuspendedTickets), ctx, ids)
+}
+
+// DeleteTarget mocks base method.
+func (m *Client) DeleteTarget(ctx context.Context, ticketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTarget", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTarget indicates an expected call of DeleteTarget.
+func (mr *ClientMockRecorder) DeleteTarget(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTarget", reflect.TypeOf((*Client)(nil).DeleteTarget), ctx, ticketID)
+}
+
+// DeleteTicket mocks base method.
+func (m *Client) DeleteTicket(ctx context.Context, ticketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicket", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicket indicates an expected call of DeleteTicket.
+func (mr *ClientMockRecorder) DeleteTicket(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicket", reflect.TypeOf((*Client)(nil).DeleteTicket), ctx, ticketID)
+}
+
+// DeleteTicketField mocks base method.
+func (m *Client) DeleteTicketField(ctx context.Context, ticketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicketField", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicketField indicates an expected call of DeleteTicketField.
+func (mr *ClientMockRecorder) DeleteTicketField(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketField", reflect.TypeOf((*Client)(nil).DeleteTicketField), ctx, ticketID)
+}
+
+// DeleteTicketForm mocks base method.
+func (m *Client) DeleteTicketForm(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicketForm", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicketForm indicates an expected call of DeleteTicketForm.
+func (mr *ClientMockRecorder) DeleteTicketForm(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketForm", reflect.TypeOf((*Client)(nil).DeleteTicketForm), ctx, id)
+}
+
+// DeleteTrigger mocks base method.
+func (m *Client) DeleteTrigger(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrigger", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTrigger indicates an expected call of DeleteTrigger.
+func (mr *ClientMockRecorder) DeleteTrigger(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrigger", reflect.TypeOf((*Client)(nil).DeleteTrigger), ctx, id)
+}
+
+// DeleteUpload mocks base method.
 func (m *Client) DeleteUpload(ctx context.Context, token string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteUpload", ctx, token)
@@ -596,6 +1068,77 @@ func (mr *ClientMockRecorder) DeleteUpload(ctx, token any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpload", reflect.TypeOf((*Client)(nil).DeleteUpload), ctx, token)
 }
 
+// DeleteUser mocks base method.
+func (m *Client) DeleteUser(ctx context.Context, userID int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, userID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *ClientMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*Client)(nil).DeleteUser), ctx, userID)
+}
+
+// DeleteUserIdentity mocks base method.
+func (m *Client) DeleteUserIdentity(ctx context.Context, userID, identityID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserIdentity", ctx, userID, identityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserIdentity indicates an expected call of DeleteUserIdentity.
+func (mr *ClientMockRecorder) DeleteUserIdentity(ctx, userID, identityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserIdentity", reflect.TypeOf((*Client)(nil).DeleteUserIdentity), ctx, userID, identityID)
+}
+
+// DeleteUserSession mocks base method.
+func (m *Client) DeleteUserSession(ctx context.Context, userID, sessionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserSession indicates an expected call of DeleteUserSession.
+func (mr *ClientMockRecorder) DeleteUserSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSession", reflect.TypeOf((*Client)(nil).DeleteUserSession), ctx, userID, sessionID)
+}
+
+// DeleteUserSessions mocks base method.
+func (m *Client) DeleteUserSessions(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSessions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserSessions indicates an expected call of DeleteUserSessions.
+func (mr *ClientMockRecorder) DeleteUserSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSessions", reflect.TypeOf((*Client)(nil).DeleteUserSessions), ctx, userID)
+}
+
+// DeleteView mocks base method.
+func (m *Client) DeleteView(ctx context.Context, viewID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteView", ctx, viewID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteView indicates an expected call of DeleteView.
+func (mr *ClientMockRecorder) DeleteView(ctx, viewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteView", reflect.TypeOf((*Client)(nil).DeleteView), ctx, viewID)
+}
+
 // DeleteWebhook mocks base method.
 func (m *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
 	m.ctrl.T.Helper()
@@ -610,6 +1153,125 @@ func (mr *ClientMockRecorder) DeleteWebhook(ctx, webhookID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*Client)(nil).DeleteWebhook), ctx, webhookID)
 }
 
+// DestroyManyGroupMemberships mocks base method.
+func (m *Client) DestroyManyGroupMemberships(arg0 context.Context, arg1 []int64) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyManyGroupMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyManyGroupMemberships indicates an expected call of DestroyManyGroupMemberships.
+func (mr *ClientMockRecorder) DestroyManyGroupMemberships(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyManyGroupMemberships", reflect.TypeOf((*Client)(nil).DestroyManyGroupMemberships), arg0, arg1)
+}
+
+// DestroyManyOrganizationMemberships mocks base method.
+func (m *Client) DestroyManyOrganizationMemberships(arg0 context.Context, arg1 []int64) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyManyOrganizationMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyManyOrganizationMemberships indicates an expected call of DestroyManyOrganizationMemberships.
+func (mr *ClientMockRecorder) DestroyManyOrganizationMemberships(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyManyOrganizationMemberships", reflect.TypeOf((*Client)(nil).DestroyManyOrganizationMemberships), arg0, arg1)
+}
+
+// DestroyManyOrganizations mocks base method.
+func (m *Client) DestroyManyOrganizations(ctx context.Context, orgIDs []int64) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyManyOrganizations", ctx, orgIDs)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyManyOrganizations indicates an expected call of DestroyManyOrganizations.
+func (mr *ClientMockRecorder) DestroyManyOrganizations(ctx, orgIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyManyOrganizations", reflect.TypeOf((*Client)(nil).DestroyManyOrganizations), ctx, orgIDs)
+}
+
+// DestroyManyUsers mocks base method.
+func (m *Client) DestroyManyUsers(ctx context.Context, userIDs []int64) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyManyUsers", ctx, userIDs)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyManyUsers indicates an expected call of DestroyManyUsers.
+func (mr *ClientMockRecorder) DestroyManyUsers(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyManyUsers", reflect.TypeOf((*Client)(nil).DestroyManyUsers), ctx, userIDs)
+}
+
+// DestroyManyViews mocks base method.
+func (m *Client) DestroyManyViews(ctx context.Context, ids []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyManyViews", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyManyViews indicates an expected call of DestroyManyViews.
+func (mr *ClientMockRecorder) DestroyManyViews(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyManyViews", reflect.TypeOf((*Client)(nil).DestroyManyViews), ctx, ids)
+}
+
+// ExecuteView mocks base method.
+func (m *Client) ExecuteView(ctx context.Context, viewID int64, opts *zendesk.ViewExecuteOptions) (zendesk.ViewRows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteView", ctx, viewID, opts)
+	ret0, _ := ret[0].(zendesk.ViewRows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteView indicates an expected call of ExecuteView.
+func (mr *ClientMockRecorder) ExecuteView(ctx, viewID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteView", reflect.TypeOf((*Client)(nil).ExecuteView), ctx, viewID, opts)
+}
+
+// ExportSuspendedTicketAttachments mocks base method.
+func (m *Client) ExportSuspendedTicketAttachments(ctx context.Context, id int64) (zendesk.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSuspendedTicketAttachments", ctx, id)
+	ret0, _ := ret[0].(zendesk.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSuspendedTicketAttachments indicates an expected call of ExportSuspendedTicketAttachments.
+func (mr *ClientMockRecorder) ExportSuspendedTicketAttachments(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSuspendedTicketAttachments", reflect.TypeOf((*Client)(nil).ExportSuspendedTicketAttachments), ctx, id)
+}
+
+// ExportView mocks base method.
+func (m *Client) ExportView(ctx context.Context, viewID int64) (zendesk.ViewExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportView", ctx, viewID)
+	ret0, _ := ret[0].(zendesk.ViewExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportView indicates an expected call of ExportView.
+func (mr *ClientMockRecorder) ExportView(ctx, viewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportView", reflect.TypeOf((*Client)(nil).ExportView), ctx, viewID)
+}
+
 // Get mocks base method.
 func (m *Client) Get(ctx context.Context, path string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -625,6 +1287,99 @@ func (mr *ClientMockRecorder) Get(ctx, path any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*Client)(nil).Get), ctx, path)
 }
 
+// GetActiveViews mocks base method.
+func (m *Client) GetActiveViews(ctx context.Context, opts *zendesk.ViewListOptions) ([]zendesk.View, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveViews", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.View)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetActiveViews indicates an expected call of GetActiveViews.
+func (mr *ClientMockRecorder) GetActiveViews(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveViews", reflect.TypeOf((*Client)(nil).GetActiveViews), ctx, opts)
+}
+
+// GetActivities mocks base method.
+func (m *Client) GetActivities(ctx context.Context, opts *zendesk.ActivityListOptions) ([]zendesk.Activity, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivities", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Activity)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetActivities indicates an expected call of GetActivities.
+func (mr *ClientMockRecorder) GetActivities(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivities", reflect.TypeOf((*Client)(nil).GetActivities), ctx, opts)
+}
+
+// GetActivitiesCBP mocks base method.
+func (m *Client) GetActivitiesCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Activity, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivitiesCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Activity)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetActivitiesCBP indicates an expected call of GetActivitiesCBP.
+func (mr *ClientMockRecorder) GetActivitiesCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivitiesCBP", reflect.TypeOf((*Client)(nil).GetActivitiesCBP), ctx, opts)
+}
+
+// GetActivitiesIterator mocks base method.
+func (m *Client) GetActivitiesIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Activity] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivitiesIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Activity])
+	return ret0
+}
+
+// GetActivitiesIterator indicates an expected call of GetActivitiesIterator.
+func (mr *ClientMockRecorder) GetActivitiesIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivitiesIterator", reflect.TypeOf((*Client)(nil).GetActivitiesIterator), ctx, opts)
+}
+
+// GetActivitiesOBP mocks base method.
+func (m *Client) GetActivitiesOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Activity, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivitiesOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Activity)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetActivitiesOBP indicates an expected call of GetActivitiesOBP.
+func (mr *ClientMockRecorder) GetActivitiesOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivitiesOBP", reflect.TypeOf((*Client)(nil).GetActivitiesOBP), ctx, opts)
+}
+
+// GetActivity mocks base method.
+func (m *Client) GetActivity(ctx context.Context, id int64) (zendesk.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivity", ctx, id)
+	ret0, _ := ret[0].(zendesk.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivity indicates an expected call of GetActivity.
+func (mr *ClientMockRecorder) GetActivity(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivity", reflect.TypeOf((*Client)(nil).GetActivity), ctx, id)
+}
+
 // GetAllTicketAudits mocks base method.
 func (m *Client) GetAllTicketAudits(ctx context.Context, opts zendesk.CursorOption) ([]zendesk.TicketAudit, zendesk.Cursor, error) {
 	m.ctrl.T.Helper()
@@ -641,6 +1396,38 @@ func (mr *ClientMockRecorder) GetAllTicketAudits(ctx, opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTicketAudits", reflect.TypeOf((*Client)(nil).GetAllTicketAudits), ctx, opts)
 }
 
+// GetAssignableGroupMemberships mocks base method.
+func (m *Client) GetAssignableGroupMemberships(ctx context.Context, groupID int64, opts *zendesk.PageOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignableGroupMemberships", ctx, groupID, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAssignableGroupMemberships indicates an expected call of GetAssignableGroupMemberships.
+func (mr *ClientMockRecorder) GetAssignableGroupMemberships(ctx, groupID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignableGroupMemberships", reflect.TypeOf((*Client)(nil).GetAssignableGroupMemberships), ctx, groupID, opts)
+}
+
+// GetAssignableGroups mocks base method.
+func (m *Client) GetAssignableGroups(ctx context.Context, opts *zendesk.GroupListOptions) ([]zendesk.Group, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignableGroups", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAssignableGroups indicates an expected call of GetAssignableGroups.
+func (mr *ClientMockRecorder) GetAssignableGroups(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignableGroups", reflect.TypeOf((*Client)(nil).GetAssignableGroups), ctx, opts)
+}
+
 // GetAttachment mocks base method.
 func (m *Client) GetAttachment(ctx context.Context, id int64) (zendesk.Attachment, error) {
 	m.ctrl.T.Helper()
@@ -748,19 +1535,79 @@ func (mr *ClientMockRecorder) GetBrand(ctx, brandID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrand", reflect.TypeOf((*Client)(nil).GetBrand), ctx, brandID)
 }
 
+// GetCompactViews mocks base method.
+func (m *Client) GetCompactViews(ctx context.Context) ([]zendesk.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompactViews", ctx)
+	ret0, _ := ret[0].([]zendesk.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompactViews indicates an expected call of GetCompactViews.
+func (mr *ClientMockRecorder) GetCompactViews(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompactViews", reflect.TypeOf((*Client)(nil).GetCompactViews), ctx)
+}
+
 // GetCountTicketsInViews mocks base method.
-func (m *Client) GetCountTicketsInViews(arg0 context.Context, arg1 []string) ([]zendesk.ViewCount, error) {
+func (m *Client) GetCountTicketsInViews(ctx context.Context, ids []string) ([]zendesk.ViewCount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCountTicketsInViews", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetCountTicketsInViews", ctx, ids)
 	ret0, _ := ret[0].([]zendesk.ViewCount)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCountTicketsInViews indicates an expected call of GetCountTicketsInViews.
-func (mr *ClientMockRecorder) GetCountTicketsInViews(arg0, arg1 interface{}) *gomock.Call {
+func (mr *ClientMockRecorder) GetCountTicketsInViews(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountTicketsInViews", reflect.TypeOf((*Client)(nil).GetCountTicketsInViews), ctx, ids)
+}
+
+// GetCurrentSession mocks base method.
+func (m *Client) GetCurrentSession(ctx context.Context) (zendesk.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentSession", ctx)
+	ret0, _ := ret[0].(zendesk.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentSession indicates an expected call of GetCurrentSession.
+func (mr *ClientMockRecorder) GetCurrentSession(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentSession", reflect.TypeOf((*Client)(nil).GetCurrentSession), ctx)
+}
+
+// GetCurrentUser mocks base method.
+func (m *Client) GetCurrentUser(ctx context.Context) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentUser", ctx)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentUser indicates an expected call of GetCurrentUser.
+func (mr *ClientMockRecorder) GetCurrentUser(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUser", reflect.TypeOf((*Client)(nil).GetCurrentUser), ctx)
+}
+
+// GetCustomRole mocks base method.
+func (m *Client) GetCustomRole(ctx context.Context, roleID int64) (zendesk.CustomRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomRole", ctx, roleID)
+	ret0, _ := ret[0].(zendesk.CustomRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomRole indicates an expected call of GetCustomRole.
+func (mr *ClientMockRecorder) GetCustomRole(ctx, roleID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountTicketsInViews", reflect.TypeOf((*Client)(nil).GetCountTicketsInViews), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRole", reflect.TypeOf((*Client)(nil).GetCustomRole), ctx, roleID)
 }
 
 // GetCustomRoles mocks base method.
@@ -778,6 +1625,113 @@ func (mr *ClientMockRecorder) GetCustomRoles(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRoles", reflect.TypeOf((*Client)(nil).GetCustomRoles), ctx)
 }
 
+// GetCustomStatus mocks base method.
+func (m *Client) GetCustomStatus(ctx context.Context, id int64) (zendesk.CustomStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomStatus", ctx, id)
+	ret0, _ := ret[0].(zendesk.CustomStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomStatus indicates an expected call of GetCustomStatus.
+func (mr *ClientMockRecorder) GetCustomStatus(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomStatus", reflect.TypeOf((*Client)(nil).GetCustomStatus), ctx, id)
+}
+
+// GetCustomStatuses mocks base method.
+func (m *Client) GetCustomStatuses(ctx context.Context, opts *zendesk.CustomStatusListOptions) ([]zendesk.CustomStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomStatuses", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.CustomStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomStatuses indicates an expected call of GetCustomStatuses.
+func (mr *ClientMockRecorder) GetCustomStatuses(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomStatuses", reflect.TypeOf((*Client)(nil).GetCustomStatuses), ctx, opts)
+}
+
+// GetDeletedUser mocks base method.
+func (m *Client) GetDeletedUser(ctx context.Context, userID int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedUser", ctx, userID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedUser indicates an expected call of GetDeletedUser.
+func (mr *ClientMockRecorder) GetDeletedUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedUser", reflect.TypeOf((*Client)(nil).GetDeletedUser), ctx, userID)
+}
+
+// GetDeletedUsers mocks base method.
+func (m *Client) GetDeletedUsers(ctx context.Context, opts *zendesk.PageOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedUsers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeletedUsers indicates an expected call of GetDeletedUsers.
+func (mr *ClientMockRecorder) GetDeletedUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedUsers", reflect.TypeOf((*Client)(nil).GetDeletedUsers), ctx, opts)
+}
+
+// GetDeletedUsersCBP mocks base method.
+func (m *Client) GetDeletedUsersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedUsersCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeletedUsersCBP indicates an expected call of GetDeletedUsersCBP.
+func (mr *ClientMockRecorder) GetDeletedUsersCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedUsersCBP", reflect.TypeOf((*Client)(nil).GetDeletedUsersCBP), ctx, opts)
+}
+
+// GetDeletedUsersIterator mocks base method.
+func (m *Client) GetDeletedUsersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.User] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedUsersIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.User])
+	return ret0
+}
+
+// GetDeletedUsersIterator indicates an expected call of GetDeletedUsersIterator.
+func (mr *ClientMockRecorder) GetDeletedUsersIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedUsersIterator", reflect.TypeOf((*Client)(nil).GetDeletedUsersIterator), ctx, opts)
+}
+
+// GetDeletedUsersOBP mocks base method.
+func (m *Client) GetDeletedUsersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedUsersOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeletedUsersOBP indicates an expected call of GetDeletedUsersOBP.
+func (mr *ClientMockRecorder) GetDeletedUsersOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedUsersOBP", reflect.TypeOf((*Client)(nil).GetDeletedUsersOBP), ctx, opts)
+}
+
 // GetDynamicContentItem mocks base method.
 func (m *Client) GetDynamicContentItem(ctx context.Context, id int64) (zendesk.DynamicContentItem, error) {
 	m.ctrl.T.Helper()
@@ -796,804 +1750,1539 @@ func (mr *ClientMockRecorder) GetDynamicContentItem(ctx, id any) *gomock.Call {
 // GetDynamicContentItems mocks base method.
 func (m *Client) GetDynamicContentItems(ctx context.Context) ([]zendesk.DynamicContentItem, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItems", ctx)
-	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret := m.ctrl.Call(m, "GetDynamicContentItems", ctx)
+	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDynamicContentItems indicates an expected call of GetDynamicContentItems.
+func (mr *ClientMockRecorder) GetDynamicContentItems(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItems", reflect.TypeOf((*Client)(nil).GetDynamicContentItems), ctx)
+}
+
+// GetDynamicContentItemsCBP mocks base method.
+func (m *Client) GetDynamicContentItemsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.DynamicContentItem, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicContentItemsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDynamicContentItemsCBP indicates an expected call of GetDynamicContentItemsCBP.
+func (mr *ClientMockRecorder) GetDynamicContentItemsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsCBP", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsCBP), ctx, opts)
+}
+
+// GetDynamicContentItemsIterator mocks base method.
+func (m *Client) GetDynamicContentItemsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.DynamicContentItem] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicContentItemsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.DynamicContentItem])
+	return ret0
+}
+
+// GetDynamicContentItemsIterator indicates an expected call of GetDynamicContentItemsIterator.
+func (mr *ClientMockRecorder) GetDynamicContentItemsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsIterator", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsIterator), ctx, opts)
+}
+
+// GetDynamicContentItemsOBP mocks base method.
+func (m *Client) GetDynamicContentItemsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.DynamicContentItem, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicContentItemsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDynamicContentItemsOBP indicates an expected call of GetDynamicContentItemsOBP.
+func (mr *ClientMockRecorder) GetDynamicContentItemsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsOBP", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsOBP), ctx, opts)
+}
+
+// GetGroup mocks base method.
+func (m *Client) GetGroup(ctx context.Context, groupID int64) (zendesk.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroup", ctx, groupID)
+	ret0, _ := ret[0].(zendesk.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroup indicates an expected call of GetGroup.
+func (mr *ClientMockRecorder) GetGroup(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*Client)(nil).GetGroup), ctx, groupID)
+}
+
+// GetGroupMembership mocks base method.
+func (m *Client) GetGroupMembership(arg0 context.Context, arg1 int64) (zendesk.GroupMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembership", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.GroupMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupMembership indicates an expected call of GetGroupMembership.
+func (mr *ClientMockRecorder) GetGroupMembership(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembership", reflect.TypeOf((*Client)(nil).GetGroupMembership), arg0, arg1)
+}
+
+// GetGroupMemberships mocks base method.
+func (m *Client) GetGroupMemberships(arg0 context.Context, arg1 *zendesk.GroupMembershipListOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupMemberships indicates an expected call of GetGroupMemberships.
+func (mr *ClientMockRecorder) GetGroupMemberships(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMemberships", reflect.TypeOf((*Client)(nil).GetGroupMemberships), arg0, arg1)
+}
+
+// GetGroupMembershipsCBP mocks base method.
+func (m *Client) GetGroupMembershipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.GroupMembership, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupMembershipsCBP indicates an expected call of GetGroupMembershipsCBP.
+func (mr *ClientMockRecorder) GetGroupMembershipsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsCBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsCBP), ctx, opts)
+}
+
+// GetGroupMembershipsIterator mocks base method.
+func (m *Client) GetGroupMembershipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.GroupMembership] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.GroupMembership])
+	return ret0
+}
+
+// GetGroupMembershipsIterator indicates an expected call of GetGroupMembershipsIterator.
+func (mr *ClientMockRecorder) GetGroupMembershipsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsIterator", reflect.TypeOf((*Client)(nil).GetGroupMembershipsIterator), ctx, opts)
+}
+
+// GetGroupMembershipsOBP mocks base method.
+func (m *Client) GetGroupMembershipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupMembershipsOBP indicates an expected call of GetGroupMembershipsOBP.
+func (mr *ClientMockRecorder) GetGroupMembershipsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsOBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsOBP), ctx, opts)
+}
+
+// GetGroups mocks base method.
+func (m *Client) GetGroups(ctx context.Context, opts *zendesk.GroupListOptions) ([]zendesk.Group, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroups", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroups indicates an expected call of GetGroups.
+func (mr *ClientMockRecorder) GetGroups(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*Client)(nil).GetGroups), ctx, opts)
+}
+
+// GetGroupsCBP mocks base method.
+func (m *Client) GetGroupsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Group, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupsCBP indicates an expected call of GetGroupsCBP.
+func (mr *ClientMockRecorder) GetGroupsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsCBP", reflect.TypeOf((*Client)(nil).GetGroupsCBP), ctx, opts)
+}
+
+// GetGroupsIterator mocks base method.
+func (m *Client) GetGroupsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Group] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Group])
+	return ret0
+}
+
+// GetGroupsIterator indicates an expected call of GetGroupsIterator.
+func (mr *ClientMockRecorder) GetGroupsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsIterator", reflect.TypeOf((*Client)(nil).GetGroupsIterator), ctx, opts)
+}
+
+// GetGroupsOBP mocks base method.
+func (m *Client) GetGroupsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Group, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupsOBP indicates an expected call of GetGroupsOBP.
+func (mr *ClientMockRecorder) GetGroupsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsOBP", reflect.TypeOf((*Client)(nil).GetGroupsOBP), ctx, opts)
+}
+
+// GetJobStatus mocks base method.
+func (m *Client) GetJobStatus(ctx context.Context, id string) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobStatus", ctx, id)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobStatus indicates an expected call of GetJobStatus.
+func (mr *ClientMockRecorder) GetJobStatus(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobStatus", reflect.TypeOf((*Client)(nil).GetJobStatus), ctx, id)
+}
+
+// GetJobStatuses mocks base method.
+func (m *Client) GetJobStatuses(ctx context.Context, ids []string) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobStatuses", ctx, ids)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobStatuses indicates an expected call of GetJobStatuses.
+func (mr *ClientMockRecorder) GetJobStatuses(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobStatuses", reflect.TypeOf((*Client)(nil).GetJobStatuses), ctx, ids)
+}
+
+// GetLocales mocks base method.
+func (m *Client) GetLocales(ctx context.Context) ([]zendesk.Locale, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocales", ctx)
+	ret0, _ := ret[0].([]zendesk.Locale)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocales indicates an expected call of GetLocales.
+func (mr *ClientMockRecorder) GetLocales(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocales", reflect.TypeOf((*Client)(nil).GetLocales), ctx)
+}
+
+// GetMacro mocks base method.
+func (m *Client) GetMacro(ctx context.Context, macroID int64) (zendesk.Macro, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacro", ctx, macroID)
+	ret0, _ := ret[0].(zendesk.Macro)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMacro indicates an expected call of GetMacro.
+func (mr *ClientMockRecorder) GetMacro(ctx, macroID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacro", reflect.TypeOf((*Client)(nil).GetMacro), ctx, macroID)
+}
+
+// GetMacros mocks base method.
+func (m *Client) GetMacros(ctx context.Context, opts *zendesk.MacroListOptions) ([]zendesk.Macro, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacros", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Macro)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMacros indicates an expected call of GetMacros.
+func (mr *ClientMockRecorder) GetMacros(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacros", reflect.TypeOf((*Client)(nil).GetMacros), ctx, opts)
+}
+
+// GetMacrosCBP mocks base method.
+func (m *Client) GetMacrosCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Macro, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacrosCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Macro)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMacrosCBP indicates an expected call of GetMacrosCBP.
+func (mr *ClientMockRecorder) GetMacrosCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosCBP", reflect.TypeOf((*Client)(nil).GetMacrosCBP), ctx, opts)
+}
+
+// GetMacrosIterator mocks base method.
+func (m *Client) GetMacrosIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Macro] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacrosIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Macro])
+	return ret0
+}
+
+// GetMacrosIterator indicates an expected call of GetMacrosIterator.
+func (mr *ClientMockRecorder) GetMacrosIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosIterator", reflect.TypeOf((*Client)(nil).GetMacrosIterator), ctx, opts)
+}
+
+// GetMacrosOBP mocks base method.
+func (m *Client) GetMacrosOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Macro, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacrosOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Macro)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMacrosOBP indicates an expected call of GetMacrosOBP.
+func (mr *ClientMockRecorder) GetMacrosOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosOBP", reflect.TypeOf((*Client)(nil).GetMacrosOBP), ctx, opts)
+}
+
+// GetManyOrganizations mocks base method.
+func (m *Client) GetManyOrganizations(ctx context.Context, opts *zendesk.GetManyOrganizationsOptions) ([]zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManyOrganizations", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManyOrganizations indicates an expected call of GetManyOrganizations.
+func (mr *ClientMockRecorder) GetManyOrganizations(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyOrganizations", reflect.TypeOf((*Client)(nil).GetManyOrganizations), ctx, opts)
+}
+
+// GetManyUsers mocks base method.
+func (m *Client) GetManyUsers(ctx context.Context, opts *zendesk.GetManyUsersOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManyUsers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetManyUsers indicates an expected call of GetManyUsers.
+func (mr *ClientMockRecorder) GetManyUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyUsers", reflect.TypeOf((*Client)(nil).GetManyUsers), ctx, opts)
+}
+
+// GetMultipleTickets mocks base method.
+func (m *Client) GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultipleTickets", ctx, ticketIDs)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultipleTickets indicates an expected call of GetMultipleTickets.
+func (mr *ClientMockRecorder) GetMultipleTickets(ctx, ticketIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultipleTickets", reflect.TypeOf((*Client)(nil).GetMultipleTickets), ctx, ticketIDs)
+}
+
+// GetOrganization mocks base method.
+func (m *Client) GetOrganization(ctx context.Context, orgID int64) (zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganization", ctx, orgID)
+	ret0, _ := ret[0].(zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganization indicates an expected call of GetOrganization.
+func (mr *ClientMockRecorder) GetOrganization(ctx, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*Client)(nil).GetOrganization), ctx, orgID)
+}
+
+// GetOrganizationByExternalID mocks base method.
+func (m *Client) GetOrganizationByExternalID(ctx context.Context, externalID string) ([]zendesk.Organization, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationByExternalID", ctx, externalID)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationByExternalID indicates an expected call of GetOrganizationByExternalID.
+func (mr *ClientMockRecorder) GetOrganizationByExternalID(ctx, externalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationByExternalID", reflect.TypeOf((*Client)(nil).GetOrganizationByExternalID), ctx, externalID)
+}
+
+// GetOrganizationFields mocks base method.
+func (m *Client) GetOrganizationFields(ctx context.Context) ([]zendesk.OrganizationField, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationFields", ctx)
+	ret0, _ := ret[0].([]zendesk.OrganizationField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationFields indicates an expected call of GetOrganizationFields.
+func (mr *ClientMockRecorder) GetOrganizationFields(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFields", reflect.TypeOf((*Client)(nil).GetOrganizationFields), ctx)
+}
+
+// GetOrganizationFieldsCBP mocks base method.
+func (m *Client) GetOrganizationFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.OrganizationField, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationFieldsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationField)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationFieldsCBP indicates an expected call of GetOrganizationFieldsCBP.
+func (mr *ClientMockRecorder) GetOrganizationFieldsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsCBP), ctx, opts)
+}
+
+// GetOrganizationFieldsIterator mocks base method.
+func (m *Client) GetOrganizationFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.OrganizationField] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationFieldsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.OrganizationField])
+	return ret0
+}
+
+// GetOrganizationFieldsIterator indicates an expected call of GetOrganizationFieldsIterator.
+func (mr *ClientMockRecorder) GetOrganizationFieldsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsIterator), ctx, opts)
+}
+
+// GetOrganizationFieldsOBP mocks base method.
+func (m *Client) GetOrganizationFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.OrganizationField, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationFieldsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationFieldsOBP indicates an expected call of GetOrganizationFieldsOBP.
+func (mr *ClientMockRecorder) GetOrganizationFieldsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsOBP), ctx, opts)
+}
+
+// GetOrganizationMembership mocks base method.
+func (m *Client) GetOrganizationMembership(arg0 context.Context, arg1 int64) (zendesk.OrganizationMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMembership", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.OrganizationMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMembership indicates an expected call of GetOrganizationMembership.
+func (mr *ClientMockRecorder) GetOrganizationMembership(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembership", reflect.TypeOf((*Client)(nil).GetOrganizationMembership), arg0, arg1)
+}
+
+// GetOrganizationMemberships mocks base method.
+func (m *Client) GetOrganizationMemberships(arg0 context.Context, arg1 *zendesk.OrganizationMembershipListOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationMemberships indicates an expected call of GetOrganizationMemberships.
+func (mr *ClientMockRecorder) GetOrganizationMemberships(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMemberships", reflect.TypeOf((*Client)(nil).GetOrganizationMemberships), arg0, arg1)
+}
+
+// GetOrganizationMembershipsCBP mocks base method.
+func (m *Client) GetOrganizationMembershipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.OrganizationMembership, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMembershipsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationMembershipsCBP indicates an expected call of GetOrganizationMembershipsCBP.
+func (mr *ClientMockRecorder) GetOrganizationMembershipsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsCBP), ctx, opts)
+}
+
+// GetOrganizationMembershipsIterator mocks base method.
+func (m *Client) GetOrganizationMembershipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.OrganizationMembership] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMembershipsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.OrganizationMembership])
+	return ret0
+}
+
+// GetOrganizationMembershipsIterator indicates an expected call of GetOrganizationMembershipsIterator.
+func (mr *ClientMockRecorder) GetOrganizationMembershipsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsIterator), ctx, opts)
+}
+
+// GetOrganizationMembershipsOBP mocks base method.
+func (m *Client) GetOrganizationMembershipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMembershipsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationMembershipsOBP indicates an expected call of GetOrganizationMembershipsOBP.
+func (mr *ClientMockRecorder) GetOrganizationMembershipsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsOBP), ctx, opts)
+}
+
+// GetOrganizationMerge mocks base method.
+func (m *Client) GetOrganizationMerge(ctx context.Context, mergeID string) (zendesk.OrganizationMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMerge", ctx, mergeID)
+	ret0, _ := ret[0].(zendesk.OrganizationMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMerge indicates an expected call of GetOrganizationMerge.
+func (mr *ClientMockRecorder) GetOrganizationMerge(ctx, mergeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMerge", reflect.TypeOf((*Client)(nil).GetOrganizationMerge), ctx, mergeID)
+}
+
+// GetOrganizationRelated mocks base method.
+func (m *Client) GetOrganizationRelated(ctx context.Context, orgID int64) (zendesk.OrganizationRelated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationRelated", ctx, orgID)
+	ret0, _ := ret[0].(zendesk.OrganizationRelated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationRelated indicates an expected call of GetOrganizationRelated.
+func (mr *ClientMockRecorder) GetOrganizationRelated(ctx, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationRelated", reflect.TypeOf((*Client)(nil).GetOrganizationRelated), ctx, orgID)
+}
+
+// GetOrganizationSubscription mocks base method.
+func (m *Client) GetOrganizationSubscription(arg0 context.Context, arg1 int64) (zendesk.OrganizationSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationSubscription", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.OrganizationSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationSubscription indicates an expected call of GetOrganizationSubscription.
+func (mr *ClientMockRecorder) GetOrganizationSubscription(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationSubscription", reflect.TypeOf((*Client)(nil).GetOrganizationSubscription), arg0, arg1)
+}
+
+// GetOrganizationSubscriptions mocks base method.
+func (m *Client) GetOrganizationSubscriptions(arg0 context.Context, arg1 *zendesk.OrganizationSubscriptionListOptions) ([]zendesk.OrganizationSubscription, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationSubscriptions", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.OrganizationSubscription)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationSubscriptions indicates an expected call of GetOrganizationSubscriptions.
+func (mr *ClientMockRecorder) GetOrganizationSubscriptions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationSubscriptions", reflect.TypeOf((*Client)(nil).GetOrganizationSubscriptions), arg0, arg1)
+}
+
+// GetOrganizationSubscriptionsCBP mocks base method.
+func (m *Client) GetOrganizationSubscriptionsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.OrganizationSubscription, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationSubscriptionsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationSubscription)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationSubscriptionsCBP indicates an expected call of GetOrganizationSubscriptionsCBP.
+func (mr *ClientMockRecorder) GetOrganizationSubscriptionsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationSubscriptionsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationSubscriptionsCBP), ctx, opts)
+}
+
+// GetOrganizationSubscriptionsIterator mocks base method.
+func (m *Client) GetOrganizationSubscriptionsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.OrganizationSubscription] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationSubscriptionsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.OrganizationSubscription])
+	return ret0
+}
+
+// GetOrganizationSubscriptionsIterator indicates an expected call of GetOrganizationSubscriptionsIterator.
+func (mr *ClientMockRecorder) GetOrganizationSubscriptionsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationSubscriptionsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationSubscriptionsIterator), ctx, opts)
+}
+
+// GetOrganizationSubscriptionsOBP mocks base method.
+func (m *Client) GetOrganizationSubscriptionsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.OrganizationSubscription, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationSubscriptionsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationSubscription)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationSubscriptionsOBP indicates an expected call of GetOrganizationSubscriptionsOBP.
+func (mr *ClientMockRecorder) GetOrganizationSubscriptionsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationSubscriptionsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationSubscriptionsOBP), ctx, opts)
+}
+
+// GetOrganizationTags mocks base method.
+func (m *Client) GetOrganizationTags(ctx context.Context, organizationID int64) ([]zendesk.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTags", ctx, organizationID)
+	ret0, _ := ret[0].([]zendesk.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationTags indicates an expected call of GetOrganizationTags.
+func (mr *ClientMockRecorder) GetOrganizationTags(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTags", reflect.TypeOf((*Client)(nil).GetOrganizationTags), ctx, organizationID)
+}
+
+// GetOrganizationTickets mocks base method.
+func (m *Client) GetOrganizationTickets(ctx context.Context, organizationID int64, ops *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTickets", ctx, organizationID, ops)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationTickets indicates an expected call of GetOrganizationTickets.
+func (mr *ClientMockRecorder) GetOrganizationTickets(ctx, organizationID, ops any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTickets", reflect.TypeOf((*Client)(nil).GetOrganizationTickets), ctx, organizationID, ops)
+}
+
+// GetOrganizationTicketsCBP mocks base method.
+func (m *Client) GetOrganizationTicketsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTicketsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationTicketsCBP indicates an expected call of GetOrganizationTicketsCBP.
+func (mr *ClientMockRecorder) GetOrganizationTicketsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsCBP), ctx, opts)
+}
+
+// GetOrganizationTicketsIterator mocks base method.
+func (m *Client) GetOrganizationTicketsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Ticket] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTicketsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Ticket])
+	return ret0
+}
+
+// GetOrganizationTicketsIterator indicates an expected call of GetOrganizationTicketsIterator.
+func (mr *ClientMockRecorder) GetOrganizationTicketsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsIterator), ctx, opts)
+}
+
+// GetOrganizationTicketsOBP mocks base method.
+func (m *Client) GetOrganizationTicketsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTicketsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationTicketsOBP indicates an expected call of GetOrganizationTicketsOBP.
+func (mr *ClientMockRecorder) GetOrganizationTicketsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsOBP), ctx, opts)
+}
+
+// GetOrganizationUsers mocks base method.
+func (m *Client) GetOrganizationUsers(ctx context.Context, orgID int64, opts *zendesk.UserListOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationUsers", ctx, orgID, opts)
+	ret0, _ := ret[0].([]zendesk.User)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetDynamicContentItems indicates an expected call of GetDynamicContentItems.
-func (mr *ClientMockRecorder) GetDynamicContentItems(ctx any) *gomock.Call {
+// GetOrganizationUsers indicates an expected call of GetOrganizationUsers.
+func (mr *ClientMockRecorder) GetOrganizationUsers(ctx, orgID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItems", reflect.TypeOf((*Client)(nil).GetDynamicContentItems), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsers", reflect.TypeOf((*Client)(nil).GetOrganizationUsers), ctx, orgID, opts)
 }
 
-// GetDynamicContentItemsCBP mocks base method.
-func (m *Client) GetDynamicContentItemsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.DynamicContentItem, zendesk.CursorPaginationMeta, error) {
+// GetOrganizationUsersCBP mocks base method.
+func (m *Client) GetOrganizationUsersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItemsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret := m.ctrl.Call(m, "GetOrganizationUsersCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetDynamicContentItemsCBP indicates an expected call of GetDynamicContentItemsCBP.
-func (mr *ClientMockRecorder) GetDynamicContentItemsCBP(ctx, opts any) *gomock.Call {
+// GetOrganizationUsersCBP indicates an expected call of GetOrganizationUsersCBP.
+func (mr *ClientMockRecorder) GetOrganizationUsersCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsCBP", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersCBP", reflect.TypeOf((*Client)(nil).GetOrganizationUsersCBP), ctx, opts)
 }
 
-// GetDynamicContentItemsIterator mocks base method.
-func (m *Client) GetDynamicContentItemsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.DynamicContentItem] {
+// GetOrganizationUsersIterator mocks base method.
+func (m *Client) GetOrganizationUsersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.User] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItemsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.DynamicContentItem])
+	ret := m.ctrl.Call(m, "GetOrganizationUsersIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.User])
 	return ret0
 }
 
-// GetDynamicContentItemsIterator indicates an expected call of GetDynamicContentItemsIterator.
-func (mr *ClientMockRecorder) GetDynamicContentItemsIterator(ctx, opts any) *gomock.Call {
+// GetOrganizationUsersIterator indicates an expected call of GetOrganizationUsersIterator.
+func (mr *ClientMockRecorder) GetOrganizationUsersIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsIterator", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersIterator", reflect.TypeOf((*Client)(nil).GetOrganizationUsersIterator), ctx, opts)
 }
 
-// GetDynamicContentItemsOBP mocks base method.
-func (m *Client) GetDynamicContentItemsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.DynamicContentItem, zendesk.Page, error) {
+// GetOrganizationUsersOBP mocks base method.
+func (m *Client) GetOrganizationUsersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.User, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItemsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret := m.ctrl.Call(m, "GetOrganizationUsersOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetDynamicContentItemsOBP indicates an expected call of GetDynamicContentItemsOBP.
-func (mr *ClientMockRecorder) GetDynamicContentItemsOBP(ctx, opts any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsOBP", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsOBP), ctx, opts)
-}
-
-// GetGroup mocks base method.
-func (m *Client) GetGroup(ctx context.Context, groupID int64) (zendesk.Group, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroup", ctx, groupID)
-	ret0, _ := ret[0].(zendesk.Group)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetGroup indicates an expected call of GetGroup.
-func (mr *ClientMockRecorder) GetGroup(ctx, groupID any) *gomock.Call {
+// GetOrganizationUsersOBP indicates an expected call of GetOrganizationUsersOBP.
+func (mr *ClientMockRecorder) GetOrganizationUsersOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*Client)(nil).GetGroup), ctx, groupID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersOBP", reflect.TypeOf((*Client)(nil).GetOrganizationUsersOBP), ctx, opts)
 }
 
-// GetGroupMemberships mocks base method.
-func (m *Client) GetGroupMemberships(arg0 context.Context, arg1 *zendesk.GroupMembershipListOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+// GetOrganizations mocks base method.
+func (m *Client) GetOrganizations(ctx context.Context, opts *zendesk.OrganizationListOptions) ([]zendesk.Organization, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupMemberships", arg0, arg1)
-	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret := m.ctrl.Call(m, "GetOrganizations", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Organization)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupMemberships indicates an expected call of GetGroupMemberships.
-func (mr *ClientMockRecorder) GetGroupMemberships(arg0, arg1 any) *gomock.Call {
+// GetOrganizations indicates an expected call of GetOrganizations.
+func (mr *ClientMockRecorder) GetOrganizations(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMemberships", reflect.TypeOf((*Client)(nil).GetGroupMemberships), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizations", reflect.TypeOf((*Client)(nil).GetOrganizations), ctx, opts)
 }
 
-// GetGroupMembershipsCBP mocks base method.
-func (m *Client) GetGroupMembershipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.GroupMembership, zendesk.CursorPaginationMeta, error) {
+// GetOrganizationsCBP mocks base method.
+func (m *Client) GetOrganizationsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Organization, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupMembershipsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret := m.ctrl.Call(m, "GetOrganizationsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Organization)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupMembershipsCBP indicates an expected call of GetGroupMembershipsCBP.
-func (mr *ClientMockRecorder) GetGroupMembershipsCBP(ctx, opts any) *gomock.Call {
+// GetOrganizationsCBP indicates an expected call of GetOrganizationsCBP.
+func (mr *ClientMockRecorder) GetOrganizationsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsCBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationsCBP), ctx, opts)
 }
 
-// GetGroupMembershipsIterator mocks base method.
-func (m *Client) GetGroupMembershipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.GroupMembership] {
+// GetOrganizationsIterator mocks base method.
+func (m *Client) GetOrganizationsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Organization] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupMembershipsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.GroupMembership])
+	ret := m.ctrl.Call(m, "GetOrganizationsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Organization])
 	return ret0
 }
 
-// GetGroupMembershipsIterator indicates an expected call of GetGroupMembershipsIterator.
-func (mr *ClientMockRecorder) GetGroupMembershipsIterator(ctx, opts any) *gomock.Call {
+// GetOrganizationsIterator indicates an expected call of GetOrganizationsIterator.
+func (mr *ClientMockRecorder) GetOrganizationsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsIterator", reflect.TypeOf((*Client)(nil).GetGroupMembershipsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationsIterator), ctx, opts)
 }
 
-// GetGroupMembershipsOBP mocks base method.
-func (m *Client) GetGroupMembershipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+// GetOrganizationsOBP mocks base method.
+func (m *Client) GetOrganizationsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Organization, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupMembershipsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret := m.ctrl.Call(m, "GetOrganizationsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Organization)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupMembershipsOBP indicates an expected call of GetGroupMembershipsOBP.
-func (mr *ClientMockRecorder) GetGroupMembershipsOBP(ctx, opts any) *gomock.Call {
+// GetOrganizationsOBP indicates an expected call of GetOrganizationsOBP.
+func (mr *ClientMockRecorder) GetOrganizationsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsOBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationsOBP), ctx, opts)
 }
 
-// GetGroups mocks base method.
-func (m *Client) GetGroups(ctx context.Context, opts *zendesk.GroupListOptions) ([]zendesk.Group, zendesk.Page, error) {
+// GetProblems mocks base method.
+func (m *Client) GetProblems(ctx context.Context, opts *zendesk.PageOptions) ([]zendesk.Ticket, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroups", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Group)
+	ret := m.ctrl.Call(m, "GetProblems", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroups indicates an expected call of GetGroups.
-func (mr *ClientMockRecorder) GetGroups(ctx, opts any) *gomock.Call {
+// GetProblems indicates an expected call of GetProblems.
+func (mr *ClientMockRecorder) GetProblems(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*Client)(nil).GetGroups), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProblems", reflect.TypeOf((*Client)(nil).GetProblems), ctx, opts)
 }
 
-// GetGroupsCBP mocks base method.
-func (m *Client) GetGroupsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Group, zendesk.CursorPaginationMeta, error) {
+// GetProblemsCBP mocks base method.
+func (m *Client) GetProblemsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Group)
+	ret := m.ctrl.Call(m, "GetProblemsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupsCBP indicates an expected call of GetGroupsCBP.
-func (mr *ClientMockRecorder) GetGroupsCBP(ctx, opts any) *gomock.Call {
+// GetProblemsCBP indicates an expected call of GetProblemsCBP.
+func (mr *ClientMockRecorder) GetProblemsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsCBP", reflect.TypeOf((*Client)(nil).GetGroupsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProblemsCBP", reflect.TypeOf((*Client)(nil).GetProblemsCBP), ctx, opts)
 }
 
-// GetGroupsIterator mocks base method.
-func (m *Client) GetGroupsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Group] {
+// GetProblemsIterator mocks base method.
+func (m *Client) GetProblemsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Ticket] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Group])
+	ret := m.ctrl.Call(m, "GetProblemsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Ticket])
 	return ret0
 }
 
-// GetGroupsIterator indicates an expected call of GetGroupsIterator.
-func (mr *ClientMockRecorder) GetGroupsIterator(ctx, opts any) *gomock.Call {
+// GetProblemsIterator indicates an expected call of GetProblemsIterator.
+func (mr *ClientMockRecorder) GetProblemsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsIterator", reflect.TypeOf((*Client)(nil).GetGroupsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProblemsIterator", reflect.TypeOf((*Client)(nil).GetProblemsIterator), ctx, opts)
 }
 
-// GetGroupsOBP mocks base method.
-func (m *Client) GetGroupsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Group, zendesk.Page, error) {
+// GetProblemsOBP mocks base method.
+func (m *Client) GetProblemsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Ticket, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Group)
+	ret := m.ctrl.Call(m, "GetProblemsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupsOBP indicates an expected call of GetGroupsOBP.
-func (mr *ClientMockRecorder) GetGroupsOBP(ctx, opts any) *gomock.Call {
+// GetProblemsOBP indicates an expected call of GetProblemsOBP.
+func (mr *ClientMockRecorder) GetProblemsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsOBP", reflect.TypeOf((*Client)(nil).GetGroupsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProblemsOBP", reflect.TypeOf((*Client)(nil).GetProblemsOBP), ctx, opts)
 }
 
-// GetLocales mocks base method.
-func (m *Client) GetLocales(ctx context.Context) ([]zendesk.Locale, error) {
+// GetRequest mocks base method.
+func (m *Client) GetRequest(ctx context.Context, id int64) (zendesk.Request, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLocales", ctx)
-	ret0, _ := ret[0].([]zendesk.Locale)
+	ret := m.ctrl.Call(m, "GetRequest", ctx, id)
+	ret0, _ := ret[0].(zendesk.Request)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLocales indicates an expected call of GetLocales.
-func (mr *ClientMockRecorder) GetLocales(ctx any) *gomock.Call {
+// GetRequest indicates an expected call of GetRequest.
+func (mr *ClientMockRecorder) GetRequest(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocales", reflect.TypeOf((*Client)(nil).GetLocales), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequest", reflect.TypeOf((*Client)(nil).GetRequest), ctx, id)
 }
 
-// GetMacro mocks base method.
-func (m *Client) GetMacro(ctx context.Context, macroID int64) (zendesk.Macro, error) {
+// GetRequestComment mocks base method.
+func (m *Client) GetRequestComment(ctx context.Context, requestID, commentID int64) (zendesk.TicketComment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacro", ctx, macroID)
-	ret0, _ := ret[0].(zendesk.Macro)
+	ret := m.ctrl.Call(m, "GetRequestComment", ctx, requestID, commentID)
+	ret0, _ := ret[0].(zendesk.TicketComment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMacro indicates an expected call of GetMacro.
-func (mr *ClientMockRecorder) GetMacro(ctx, macroID any) *gomock.Call {
+// GetRequestComment indicates an expected call of GetRequestComment.
+func (mr *ClientMockRecorder) GetRequestComment(ctx, requestID, commentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacro", reflect.TypeOf((*Client)(nil).GetMacro), ctx, macroID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestComment", reflect.TypeOf((*Client)(nil).GetRequestComment), ctx, requestID, commentID)
 }
 
-// GetMacros mocks base method.
-func (m *Client) GetMacros(ctx context.Context, opts *zendesk.MacroListOptions) ([]zendesk.Macro, zendesk.Page, error) {
+// GetRequestComments mocks base method.
+func (m *Client) GetRequestComments(ctx context.Context, requestID int64, opts *zendesk.PageOptions) ([]zendesk.TicketComment, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacros", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Macro)
+	ret := m.ctrl.Call(m, "GetRequestComments", ctx, requestID, opts)
+	ret0, _ := ret[0].([]zendesk.TicketComment)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetMacros indicates an expected call of GetMacros.
-func (mr *ClientMockRecorder) GetMacros(ctx, opts any) *gomock.Call {
+// GetRequestComments indicates an expected call of GetRequestComments.
+func (mr *ClientMockRecorder) GetRequestComments(ctx, requestID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacros", reflect.TypeOf((*Client)(nil).GetMacros), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestComments", reflect.TypeOf((*Client)(nil).GetRequestComments), ctx, requestID, opts)
 }
 
-// GetMacrosCBP mocks base method.
-func (m *Client) GetMacrosCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Macro, zendesk.CursorPaginationMeta, error) {
+// GetRequests mocks base method.
+func (m *Client) GetRequests(ctx context.Context, opts *zendesk.RequestListOptions) ([]zendesk.Request, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacrosCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Macro)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret := m.ctrl.Call(m, "GetRequests", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Request)
+	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetMacrosCBP indicates an expected call of GetMacrosCBP.
-func (mr *ClientMockRecorder) GetMacrosCBP(ctx, opts any) *gomock.Call {
+// GetRequests indicates an expected call of GetRequests.
+func (mr *ClientMockRecorder) GetRequests(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosCBP", reflect.TypeOf((*Client)(nil).GetMacrosCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequests", reflect.TypeOf((*Client)(nil).GetRequests), ctx, opts)
 }
 
-// GetMacrosIterator mocks base method.
-func (m *Client) GetMacrosIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Macro] {
+// GetRequestsCBP mocks base method.
+func (m *Client) GetRequestsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Request, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacrosIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Macro])
-	return ret0
+	ret := m.ctrl.Call(m, "GetRequestsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Request)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetMacrosIterator indicates an expected call of GetMacrosIterator.
-func (mr *ClientMockRecorder) GetMacrosIterator(ctx, opts any) *gomock.Call {
+// GetRequestsCBP indicates an expected call of GetRequestsCBP.
+func (mr *ClientMockRecorder) GetRequestsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosIterator", reflect.TypeOf((*Client)(nil).GetMacrosIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestsCBP", reflect.TypeOf((*Client)(nil).GetRequestsCBP), ctx, opts)
 }
 
-// GetMacrosOBP mocks base method.
-func (m *Client) GetMacrosOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Macro, zendesk.Page, error) {
+// GetRequestsIterator mocks base method.
+func (m *Client) GetRequestsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Request] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacrosOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Macro)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetRequestsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Request])
+	return ret0
 }
 
-// GetMacrosOBP indicates an expected call of GetMacrosOBP.
-func (mr *ClientMockRecorder) GetMacrosOBP(ctx, opts any) *gomock.Call {
+// GetRequestsIterator indicates an expected call of GetRequestsIterator.
+func (mr *ClientMockRecorder) GetRequestsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosOBP", reflect.TypeOf((*Client)(nil).GetMacrosOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestsIterator", reflect.TypeOf((*Client)(nil).GetRequestsIterator), ctx, opts)
 }
 
-// GetManyUsers mocks base method.
-func (m *Client) GetManyUsers(ctx context.Context, opts *zendesk.GetManyUsersOptions) ([]zendesk.User, zendesk.Page, error) {
+// GetRequestsOBP mocks base method.
+func (m *Client) GetRequestsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Request, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetManyUsers", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.User)
+	ret := m.ctrl.Call(m, "GetRequestsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Request)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetManyUsers indicates an expected call of GetManyUsers.
-func (mr *ClientMockRecorder) GetManyUsers(ctx, opts any) *gomock.Call {
+// GetRequestsOBP indicates an expected call of GetRequestsOBP.
+func (mr *ClientMockRecorder) GetRequestsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyUsers", reflect.TypeOf((*Client)(nil).GetManyUsers), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestsOBP", reflect.TypeOf((*Client)(nil).GetRequestsOBP), ctx, opts)
 }
 
-// GetMultipleTickets mocks base method.
-func (m *Client) GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]zendesk.Ticket, error) {
+// GetSLAPolicies mocks base method.
+func (m *Client) GetSLAPolicies(ctx context.Context, opts *zendesk.SLAPolicyListOptions) ([]zendesk.SLAPolicy, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMultipleTickets", ctx, ticketIDs)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "GetSLAPolicies", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SLAPolicy)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetMultipleTickets indicates an expected call of GetMultipleTickets.
-func (mr *ClientMockRecorder) GetMultipleTickets(ctx, ticketIDs any) *gomock.Call {
+// GetSLAPolicies indicates an expected call of GetSLAPolicies.
+func (mr *ClientMockRecorder) GetSLAPolicies(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultipleTickets", reflect.TypeOf((*Client)(nil).GetMultipleTickets), ctx, ticketIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicies", reflect.TypeOf((*Client)(nil).GetSLAPolicies), ctx, opts)
 }
 
-// GetOrganization mocks base method.
-func (m *Client) GetOrganization(ctx context.Context, orgID int64) (zendesk.Organization, error) {
+// GetSLAPoliciesCBP mocks base method.
+func (m *Client) GetSLAPoliciesCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.SLAPolicy, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganization", ctx, orgID)
-	ret0, _ := ret[0].(zendesk.Organization)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "GetSLAPoliciesCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SLAPolicy)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetOrganization indicates an expected call of GetOrganization.
-func (mr *ClientMockRecorder) GetOrganization(ctx, orgID any) *gomock.Call {
+// GetSLAPoliciesCBP indicates an expected call of GetSLAPoliciesCBP.
+func (mr *ClientMockRecorder) GetSLAPoliciesCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*Client)(nil).GetOrganization), ctx, orgID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPoliciesCBP", reflect.TypeOf((*Client)(nil).GetSLAPoliciesCBP), ctx, opts)
 }
 
-// GetOrganizationByExternalID mocks base method.
-func (m *Client) GetOrganizationByExternalID(ctx context.Context, externalID string) ([]zendesk.Organization, zendesk.Page, error) {
+// GetSLAPoliciesIterator mocks base method.
+func (m *Client) GetSLAPoliciesIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.SLAPolicy] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationByExternalID", ctx, externalID)
-	ret0, _ := ret[0].([]zendesk.Organization)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetSLAPoliciesIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.SLAPolicy])
+	return ret0
 }
 
-// GetOrganizationByExternalID indicates an expected call of GetOrganizationByExternalID.
-func (mr *ClientMockRecorder) GetOrganizationByExternalID(ctx, externalID any) *gomock.Call {
+// GetSLAPoliciesIterator indicates an expected call of GetSLAPoliciesIterator.
+func (mr *ClientMockRecorder) GetSLAPoliciesIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationByExternalID", reflect.TypeOf((*Client)(nil).GetOrganizationByExternalID), ctx, externalID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPoliciesIterator", reflect.TypeOf((*Client)(nil).GetSLAPoliciesIterator), ctx, opts)
 }
 
-// GetOrganizationFields mocks base method.
-func (m *Client) GetOrganizationFields(ctx context.Context) ([]zendesk.OrganizationField, zendesk.Page, error) {
+// GetSLAPoliciesOBP mocks base method.
+func (m *Client) GetSLAPoliciesOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.SLAPolicy, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationFields", ctx)
-	ret0, _ := ret[0].([]zendesk.OrganizationField)
+	ret := m.ctrl.Call(m, "GetSLAPoliciesOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SLAPolicy)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationFields indicates an expected call of GetOrganizationFields.
-func (mr *ClientMockRecorder) GetOrganizationFields(ctx any) *gomock.Call {
+// GetSLAPoliciesOBP indicates an expected call of GetSLAPoliciesOBP.
+func (mr *ClientMockRecorder) GetSLAPoliciesOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFields", reflect.TypeOf((*Client)(nil).GetOrganizationFields), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPoliciesOBP", reflect.TypeOf((*Client)(nil).GetSLAPoliciesOBP), ctx, opts)
 }
 
-// GetOrganizationFieldsCBP mocks base method.
-func (m *Client) GetOrganizationFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.OrganizationField, zendesk.CursorPaginationMeta, error) {
+// GetSLAPolicy mocks base method.
+func (m *Client) GetSLAPolicy(ctx context.Context, id int64) (zendesk.SLAPolicy, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationFieldsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.OrganizationField)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetSLAPolicy", ctx, id)
+	ret0, _ := ret[0].(zendesk.SLAPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationFieldsCBP indicates an expected call of GetOrganizationFieldsCBP.
-func (mr *ClientMockRecorder) GetOrganizationFieldsCBP(ctx, opts any) *gomock.Call {
+// GetSLAPolicy indicates an expected call of GetSLAPolicy.
+func (mr *ClientMockRecorder) GetSLAPolicy(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicy", reflect.TypeOf((*Client)(nil).GetSLAPolicy), ctx, id)
 }
 
-// GetOrganizationFieldsIterator mocks base method.
-func (m *Client) GetOrganizationFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.OrganizationField] {
+// GetSLAPolicyDefinitions mocks base method.
+func (m *Client) GetSLAPolicyDefinitions(ctx context.Context) (zendesk.SLAPolicyDefinitions, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationFieldsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.OrganizationField])
-	return ret0
+	ret := m.ctrl.Call(m, "GetSLAPolicyDefinitions", ctx)
+	ret0, _ := ret[0].(zendesk.SLAPolicyDefinitions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationFieldsIterator indicates an expected call of GetOrganizationFieldsIterator.
-func (mr *ClientMockRecorder) GetOrganizationFieldsIterator(ctx, opts any) *gomock.Call {
+// GetSLAPolicyDefinitions indicates an expected call of GetSLAPolicyDefinitions.
+func (mr *ClientMockRecorder) GetSLAPolicyDefinitions(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicyDefinitions", reflect.TypeOf((*Client)(nil).GetSLAPolicyDefinitions), ctx)
 }
 
-// GetOrganizationFieldsOBP mocks base method.
-func (m *Client) GetOrganizationFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.OrganizationField, zendesk.Page, error) {
+// GetSatisfactionRating mocks base method.
+func (m *Client) GetSatisfactionRating(ctx context.Context, id int64) (zendesk.SatisfactionRating, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationFieldsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.OrganizationField)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetSatisfactionRating", ctx, id)
+	ret0, _ := ret[0].(zendesk.SatisfactionRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationFieldsOBP indicates an expected call of GetOrganizationFieldsOBP.
-func (mr *ClientMockRecorder) GetOrganizationFieldsOBP(ctx, opts any) *gomock.Call {
+// GetSatisfactionRating indicates an expected call of GetSatisfactionRating.
+func (mr *ClientMockRecorder) GetSatisfactionRating(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSatisfactionRating", reflect.TypeOf((*Client)(nil).GetSatisfactionRating), ctx, id)
 }
 
-// GetOrganizationMemberships mocks base method.
-func (m *Client) GetOrganizationMemberships(arg0 context.Context, arg1 *zendesk.OrganizationMembershipListOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
+// GetSatisfactionRatings mocks base method.
+func (m *Client) GetSatisfactionRatings(ctx context.Context, opts *zendesk.SatisfactionRatingListOptions) ([]zendesk.SatisfactionRating, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationMemberships", arg0, arg1)
-	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
+	ret := m.ctrl.Call(m, "GetSatisfactionRatings", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SatisfactionRating)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationMemberships indicates an expected call of GetOrganizationMemberships.
-func (mr *ClientMockRecorder) GetOrganizationMemberships(arg0, arg1 any) *gomock.Call {
+// GetSatisfactionRatings indicates an expected call of GetSatisfactionRatings.
+func (mr *ClientMockRecorder) GetSatisfactionRatings(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMemberships", reflect.TypeOf((*Client)(nil).GetOrganizationMemberships), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSatisfactionRatings", reflect.TypeOf((*Client)(nil).GetSatisfactionRatings), ctx, opts)
 }
 
-// GetOrganizationMembershipsCBP mocks base method.
-func (m *Client) GetOrganizationMembershipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.OrganizationMembership, zendesk.CursorPaginationMeta, error) {
+// GetSatisfactionRatingsCBP mocks base method.
+func (m *Client) GetSatisfactionRatingsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.SatisfactionRating, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationMembershipsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
+	ret := m.ctrl.Call(m, "GetSatisfactionRatingsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SatisfactionRating)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationMembershipsCBP indicates an expected call of GetOrganizationMembershipsCBP.
-func (mr *ClientMockRecorder) GetOrganizationMembershipsCBP(ctx, opts any) *gomock.Call {
+// GetSatisfactionRatingsCBP indicates an expected call of GetSatisfactionRatingsCBP.
+func (mr *ClientMockRecorder) GetSatisfactionRatingsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSatisfactionRatingsCBP", reflect.TypeOf((*Client)(nil).GetSatisfactionRatingsCBP), ctx, opts)
 }
 
-// GetOrganizationMembershipsIterator mocks base method.
-func (m *Client) GetOrganizationMembershipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.OrganizationMembership] {
+// GetSatisfactionRatingsIterator mocks base method.
+func (m *Client) GetSatisfactionRatingsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.SatisfactionRating] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationMembershipsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.OrganizationMembership])
+	ret := m.ctrl.Call(m, "GetSatisfactionRatingsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.SatisfactionRating])
 	return ret0
 }
 
-// GetOrganizationMembershipsIterator indicates an expected call of GetOrganizationMembershipsIterator.
-func (mr *ClientMockRecorder) GetOrganizationMembershipsIterator(ctx, opts any) *gomock.Call {
+// GetSatisfactionRatingsIterator indicates an expected call of GetSatisfactionRatingsIterator.
+func (mr *ClientMockRecorder) GetSatisfactionRatingsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSatisfactionRatingsIterator", reflect.TypeOf((*Client)(nil).GetSatisfactionRatingsIterator), ctx, opts)
 }
 
-// GetOrganizationMembershipsOBP mocks base method.
-func (m *Client) GetOrganizationMembershipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
+// GetSatisfactionRatingsOBP mocks base method.
+func (m *Client) GetSatisfactionRatingsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.SatisfactionRating, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationMembershipsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
+	ret := m.ctrl.Call(m, "GetSatisfactionRatingsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SatisfactionRating)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationMembershipsOBP indicates an expected call of GetOrganizationMembershipsOBP.
-func (mr *ClientMockRecorder) GetOrganizationMembershipsOBP(ctx, opts any) *gomock.Call {
+// GetSatisfactionRatingsOBP indicates an expected call of GetSatisfactionRatingsOBP.
+func (mr *ClientMockRecorder) GetSatisfactionRatingsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSatisfactionRatingsOBP", reflect.TypeOf((*Client)(nil).GetSatisfactionRatingsOBP), ctx, opts)
 }
 
-// GetOrganizationTags mocks base method.
-func (m *Client) GetOrganizationTags(ctx context.Context, organizationID int64) ([]zendesk.Tag, error) {
+// GetSatisfactionReason mocks base method.
+func (m *Client) GetSatisfactionReason(ctx context.Context, id int64) (zendesk.SatisfactionReason, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTags", ctx, organizationID)
-	ret0, _ := ret[0].([]zendesk.Tag)
+	ret := m.ctrl.Call(m, "GetSatisfactionReason", ctx, id)
+	ret0, _ := ret[0].(zendesk.SatisfactionReason)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOrganizationTags indicates an expected call of GetOrganizationTags.
-func (mr *ClientMockRecorder) GetOrganizationTags(ctx, organizationID any) *gomock.Call {
+// GetSatisfactionReason indicates an expected call of GetSatisfactionReason.
+func (mr *ClientMockRecorder) GetSatisfactionReason(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTags", reflect.TypeOf((*Client)(nil).GetOrganizationTags), ctx, organizationID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSatisfactionReason", reflect.TypeOf((*Client)(nil).GetSatisfactionReason), ctx, id)
 }
 
-// GetOrganizationTickets mocks base method.
-func (m *Client) GetOrganizationTickets(ctx context.Context, organizationID int64, ops *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+// GetSatisfactionReasons mocks base method.
+func (m *Client) GetSatisfactionReasons(ctx context.Context) ([]zendesk.SatisfactionReason, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTickets", ctx, organizationID, ops)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetSatisfactionReasons", ctx)
+	ret0, _ := ret[0].([]zendesk.SatisfactionReason)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationTickets indicates an expected call of GetOrganizationTickets.
-func (mr *ClientMockRecorder) GetOrganizationTickets(ctx, organizationID, ops any) *gomock.Call {
+// GetSatisfactionReasons indicates an expected call of GetSatisfactionReasons.
+func (mr *ClientMockRecorder) GetSatisfactionReasons(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTickets", reflect.TypeOf((*Client)(nil).GetOrganizationTickets), ctx, organizationID, ops)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSatisfactionReasons", reflect.TypeOf((*Client)(nil).GetSatisfactionReasons), ctx)
 }
 
-// GetOrganizationTicketsCBP mocks base method.
-func (m *Client) GetOrganizationTicketsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
+// GetSchedule mocks base method.
+func (m *Client) GetSchedule(ctx context.Context, id int64) (zendesk.Schedule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTicketsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetSchedule", ctx, id)
+	ret0, _ := ret[0].(zendesk.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationTicketsCBP indicates an expected call of GetOrganizationTicketsCBP.
-func (mr *ClientMockRecorder) GetOrganizationTicketsCBP(ctx, opts any) *gomock.Call {
+// GetSchedule indicates an expected call of GetSchedule.
+func (mr *ClientMockRecorder) GetSchedule(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedule", reflect.TypeOf((*Client)(nil).GetSchedule), ctx, id)
 }
 
-// GetOrganizationTicketsIterator mocks base method.
-func (m *Client) GetOrganizationTicketsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Ticket] {
+// GetScheduleHoliday mocks base method.
+func (m *Client) GetScheduleHoliday(ctx context.Context, scheduleID, holidayID int64) (zendesk.ScheduleHoliday, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTicketsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Ticket])
-	return ret0
+	ret := m.ctrl.Call(m, "GetScheduleHoliday", ctx, scheduleID, holidayID)
+	ret0, _ := ret[0].(zendesk.ScheduleHoliday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationTicketsIterator indicates an expected call of GetOrganizationTicketsIterator.
-func (mr *ClientMockRecorder) GetOrganizationTicketsIterator(ctx, opts any) *gomock.Call {
+// GetScheduleHoliday indicates an expected call of GetScheduleHoliday.
+func (mr *ClientMockRecorder) GetScheduleHoliday(ctx, scheduleID, holidayID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduleHoliday", reflect.TypeOf((*Client)(nil).GetScheduleHoliday), ctx, scheduleID, holidayID)
 }
 
-// GetOrganizationTicketsOBP mocks base method.
-func (m *Client) GetOrganizationTicketsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+// GetScheduleHolidays mocks base method.
+func (m *Client) GetScheduleHolidays(ctx context.Context, scheduleID int64) ([]zendesk.ScheduleHoliday, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTicketsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetScheduleHolidays", ctx, scheduleID)
+	ret0, _ := ret[0].([]zendesk.ScheduleHoliday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationTicketsOBP indicates an expected call of GetOrganizationTicketsOBP.
-func (mr *ClientMockRecorder) GetOrganizationTicketsOBP(ctx, opts any) *gomock.Call {
+// GetScheduleHolidays indicates an expected call of GetScheduleHolidays.
+func (mr *ClientMockRecorder) GetScheduleHolidays(ctx, scheduleID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduleHolidays", reflect.TypeOf((*Client)(nil).GetScheduleHolidays), ctx, scheduleID)
 }
 
-// GetOrganizationUsers mocks base method.
-func (m *Client) GetOrganizationUsers(ctx context.Context, orgID int64, opts *zendesk.UserListOptions) ([]zendesk.User, zendesk.Page, error) {
+// GetSchedules mocks base method.
+func (m *Client) GetSchedules(ctx context.Context) ([]zendesk.Schedule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationUsers", ctx, orgID, opts)
-	ret0, _ := ret[0].([]zendesk.User)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetSchedules", ctx)
+	ret0, _ := ret[0].([]zendesk.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationUsers indicates an expected call of GetOrganizationUsers.
-func (mr *ClientMockRecorder) GetOrganizationUsers(ctx, orgID, opts any) *gomock.Call {
+// GetSchedules indicates an expected call of GetSchedules.
+func (mr *ClientMockRecorder) GetSchedules(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsers", reflect.TypeOf((*Client)(nil).GetOrganizationUsers), ctx, orgID, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedules", reflect.TypeOf((*Client)(nil).GetSchedules), ctx)
 }
 
-// GetOrganizationUsersCBP mocks base method.
-func (m *Client) GetOrganizationUsersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
+// GetSearchCBP mocks base method.
+func (m *Client) GetSearchCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.SearchResults, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationUsersCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.User)
+	ret := m.ctrl.Call(m, "GetSearchCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SearchResults)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationUsersCBP indicates an expected call of GetOrganizationUsersCBP.
-func (mr *ClientMockRecorder) GetOrganizationUsersCBP(ctx, opts any) *gomock.Call {
+// GetSearchCBP indicates an expected call of GetSearchCBP.
+func (mr *ClientMockRecorder) GetSearchCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersCBP", reflect.TypeOf((*Client)(nil).GetOrganizationUsersCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchCBP", reflect.TypeOf((*Client)(nil).GetSearchCBP), ctx, opts)
 }
 
-// GetOrganizationUsersIterator mocks base method.
-func (m *Client) GetOrganizationUsersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.User] {
+// GetSearchIterator mocks base method.
+func (m *Client) GetSearchIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.SearchResults] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationUsersIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.User])
+	ret := m.ctrl.Call(m, "GetSearchIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.SearchResults])
 	return ret0
 }
 
-// GetOrganizationUsersIterator indicates an expected call of GetOrganizationUsersIterator.
-func (mr *ClientMockRecorder) GetOrganizationUsersIterator(ctx, opts any) *gomock.Call {
+// GetSearchIterator indicates an expected call of GetSearchIterator.
+func (mr *ClientMockRecorder) GetSearchIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersIterator", reflect.TypeOf((*Client)(nil).GetOrganizationUsersIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchIterator", reflect.TypeOf((*Client)(nil).GetSearchIterator), ctx, opts)
 }
 
-// GetOrganizationUsersOBP mocks base method.
-func (m *Client) GetOrganizationUsersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.User, zendesk.Page, error) {
+// GetSearchOBP mocks base method.
+func (m *Client) GetSearchOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.SearchResults, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationUsersOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.User)
+	ret := m.ctrl.Call(m, "GetSearchOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SearchResults)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationUsersOBP indicates an expected call of GetOrganizationUsersOBP.
-func (mr *ClientMockRecorder) GetOrganizationUsersOBP(ctx, opts any) *gomock.Call {
+// GetSearchOBP indicates an expected call of GetSearchOBP.
+func (mr *ClientMockRecorder) GetSearchOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersOBP", reflect.TypeOf((*Client)(nil).GetOrganizationUsersOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchOBP", reflect.TypeOf((*Client)(nil).GetSearchOBP), ctx, opts)
 }
 
-// GetOrganizations mocks base method.
-func (m *Client) GetOrganizations(ctx context.Context, opts *zendesk.OrganizationListOptions) ([]zendesk.Organization, zendesk.Page, error) {
+// GetSessions mocks base method.
+func (m *Client) GetSessions(ctx context.Context, opts *zendesk.PageOptions) ([]zendesk.Session, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizations", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Organization)
+	ret := m.ctrl.Call(m, "GetSessions", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Session)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizations indicates an expected call of GetOrganizations.
-func (mr *ClientMockRecorder) GetOrganizations(ctx, opts any) *gomock.Call {
+// GetSessions indicates an expected call of GetSessions.
+func (mr *ClientMockRecorder) GetSessions(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizations", reflect.TypeOf((*Client)(nil).GetOrganizations), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*Client)(nil).GetSessions), ctx, opts)
 }
 
-// GetOrganizationsCBP mocks base method.
-func (m *Client) GetOrganizationsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Organization, zendesk.CursorPaginationMeta, error) {
+// GetSessionsCBP mocks base method.
+func (m *Client) GetSessionsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Session, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Organization)
+	ret := m.ctrl.Call(m, "GetSessionsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Session)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationsCBP indicates an expected call of GetOrganizationsCBP.
-func (mr *ClientMockRecorder) GetOrganizationsCBP(ctx, opts any) *gomock.Call {
+// GetSessionsCBP indicates an expected call of GetSessionsCBP.
+func (mr *ClientMockRecorder) GetSessionsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionsCBP", reflect.TypeOf((*Client)(nil).GetSessionsCBP), ctx, opts)
 }
 
-// GetOrganizationsIterator mocks base method.
-func (m *Client) GetOrganizationsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Organization] {
+// GetSessionsIterator mocks base method.
+func (m *Client) GetSessionsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Session] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Organization])
+	ret := m.ctrl.Call(m, "GetSessionsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Session])
 	return ret0
 }
 
-// GetOrganizationsIterator indicates an expected call of GetOrganizationsIterator.
-func (mr *ClientMockRecorder) GetOrganizationsIterator(ctx, opts any) *gomock.Call {
+// GetSessionsIterator indicates an expected call of GetSessionsIterator.
+func (mr *ClientMockRecorder) GetSessionsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionsIterator", reflect.TypeOf((*Client)(nil).GetSessionsIterator), ctx, opts)
 }
 
-// GetOrganizationsOBP mocks base method.
-func (m *Client) GetOrganizationsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Organization, zendesk.Page, error) {
+// GetSessionsOBP mocks base method.
+func (m *Client) GetSessionsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Session, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Organization)
+	ret := m.ctrl.Call(m, "GetSessionsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Session)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationsOBP indicates an expected call of GetOrganizationsOBP.
-func (mr *ClientMockRecorder) GetOrganizationsOBP(ctx, opts any) *gomock.Call {
+// GetSessionsOBP indicates an expected call of GetSessionsOBP.
+func (mr *ClientMockRecorder) GetSessionsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionsOBP", reflect.TypeOf((*Client)(nil).GetSessionsOBP), ctx, opts)
 }
 
-// GetSLAPolicies mocks base method.
-func (m *Client) GetSLAPolicies(ctx context.Context, opts *zendesk.SLAPolicyListOptions) ([]zendesk.SLAPolicy, zendesk.Page, error) {
+// GetSideConversation mocks base method.
+func (m *Client) GetSideConversation(ctx context.Context, ticketID int64, id string) (zendesk.SideConversation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSLAPolicies", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.SLAPolicy)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetSideConversation", ctx, ticketID, id)
+	ret0, _ := ret[0].(zendesk.SideConversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetSLAPolicies indicates an expected call of GetSLAPolicies.
-func (mr *ClientMockRecorder) GetSLAPolicies(ctx, opts any) *gomock.Call {
+// GetSideConversation indicates an expected call of GetSideConversation.
+func (mr *ClientMockRecorder) GetSideConversation(ctx, ticketID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicies", reflect.TypeOf((*Client)(nil).GetSLAPolicies), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSideConversation", reflect.TypeOf((*Client)(nil).GetSideConversation), ctx, ticketID, id)
 }
 
-// GetSLAPoliciesCBP mocks base method.
-func (m *Client) GetSLAPoliciesCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.SLAPolicy, zendesk.CursorPaginationMeta, error) {
+// GetSideConversationEvents mocks base method.
+func (m *Client) GetSideConversationEvents(ctx context.Context, ticketID int64, id string) ([]zendesk.SideConversationEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSLAPoliciesCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.SLAPolicy)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetSideConversationEvents", ctx, ticketID, id)
+	ret0, _ := ret[0].([]zendesk.SideConversationEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetSLAPoliciesCBP indicates an expected call of GetSLAPoliciesCBP.
-func (mr *ClientMockRecorder) GetSLAPoliciesCBP(ctx, opts any) *gomock.Call {
+// GetSideConversationEvents indicates an expected call of GetSideConversationEvents.
+func (mr *ClientMockRecorder) GetSideConversationEvents(ctx, ticketID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPoliciesCBP", reflect.TypeOf((*Client)(nil).GetSLAPoliciesCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSideConversationEvents", reflect.TypeOf((*Client)(nil).GetSideConversationEvents), ctx, ticketID, id)
 }
 
-// GetSLAPoliciesIterator mocks base method.
-func (m *Client) GetSLAPoliciesIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.SLAPolicy] {
+// GetSideConversations mocks base method.
+func (m *Client) GetSideConversations(ctx context.Context, ticketID int64, opts *zendesk.PageOptions) ([]zendesk.SideConversation, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSLAPoliciesIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.SLAPolicy])
-	return ret0
+	ret := m.ctrl.Call(m, "GetSideConversations", ctx, ticketID, opts)
+	ret0, _ := ret[0].([]zendesk.SideConversation)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetSLAPoliciesIterator indicates an expected call of GetSLAPoliciesIterator.
-func (mr *ClientMockRecorder) GetSLAPoliciesIterator(ctx, opts any) *gomock.Call {
+// GetSideConversations indicates an expected call of GetSideConversations.
+func (mr *ClientMockRecorder) GetSideConversations(ctx, ticketID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPoliciesIterator", reflect.TypeOf((*Client)(nil).GetSLAPoliciesIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSideConversations", reflect.TypeOf((*Client)(nil).GetSideConversations), ctx, ticketID, opts)
 }
 
-// GetSLAPoliciesOBP mocks base method.
-func (m *Client) GetSLAPoliciesOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.SLAPolicy, zendesk.Page, error) {
+// GetSkips mocks base method.
+func (m *Client) GetSkips(ctx context.Context, opts *zendesk.SkipListOptions) ([]zendesk.Skip, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSLAPoliciesOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.SLAPolicy)
+	ret := m.ctrl.Call(m, "GetSkips", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Skip)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetSLAPoliciesOBP indicates an expected call of GetSLAPoliciesOBP.
-func (mr *ClientMockRecorder) GetSLAPoliciesOBP(ctx, opts any) *gomock.Call {
+// GetSkips indicates an expected call of GetSkips.
+func (mr *ClientMockRecorder) GetSkips(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPoliciesOBP", reflect.TypeOf((*Client)(nil).GetSLAPoliciesOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkips", reflect.TypeOf((*Client)(nil).GetSkips), ctx, opts)
 }
 
-// GetSLAPolicy mocks base method.
-func (m *Client) GetSLAPolicy(ctx context.Context, id int64) (zendesk.SLAPolicy, error) {
+// GetSuspendedTicket mocks base method.
+func (m *Client) GetSuspendedTicket(ctx context.Context, id int64) (zendesk.SuspendedTicket, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSLAPolicy", ctx, id)
-	ret0, _ := ret[0].(zendesk.SLAPolicy)
+	ret := m.ctrl.Call(m, "GetSuspendedTicket", ctx, id)
+	ret0, _ := ret[0].(zendesk.SuspendedTicket)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSLAPolicy indicates an expected call of GetSLAPolicy.
-func (mr *ClientMockRecorder) GetSLAPolicy(ctx, id any) *gomock.Call {
+// GetSuspendedTicket indicates an expected call of GetSuspendedTicket.
+func (mr *ClientMockRecorder) GetSuspendedTicket(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicy", reflect.TypeOf((*Client)(nil).GetSLAPolicy), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuspendedTicket", reflect.TypeOf((*Client)(nil).GetSuspendedTicket), ctx, id)
 }
 
-// GetSearchCBP mocks base method.
-func (m *Client) GetSearchCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.SearchResults, zendesk.CursorPaginationMeta, error) {
+// GetSuspendedTickets mocks base method.
+func (m *Client) GetSuspendedTickets(ctx context.Context, opts *zendesk.SuspendedTicketListOptions) ([]zendesk.SuspendedTicket, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSearchCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.SearchResults)
+	ret := m.ctrl.Call(m, "GetSuspendedTickets", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SuspendedTicket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSuspendedTickets indicates an expected call of GetSuspendedTickets.
+func (mr *ClientMockRecorder) GetSuspendedTickets(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuspendedTickets", reflect.TypeOf((*Client)(nil).GetSuspendedTickets), ctx, opts)
+}
+
+// GetSuspendedTicketsCBP mocks base method.
+func (m *Client) GetSuspendedTicketsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.SuspendedTicket, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuspendedTicketsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SuspendedTicket)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetSearchCBP indicates an expected call of GetSearchCBP.
-func (mr *ClientMockRecorder) GetSearchCBP(ctx, opts any) *gomock.Call {
+// GetSuspendedTicketsCBP indicates an expected call of GetSuspendedTicketsCBP.
+func (mr *ClientMockRecorder) GetSuspendedTicketsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchCBP", reflect.TypeOf((*Client)(nil).GetSearchCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuspendedTicketsCBP", reflect.TypeOf((*Client)(nil).GetSuspendedTicketsCBP), ctx, opts)
 }
 
-// GetSearchIterator mocks base method.
-func (m *Client) GetSearchIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.SearchResults] {
+// GetSuspendedTicketsIterator mocks base method.
+func (m *Client) GetSuspendedTicketsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.SuspendedTicket] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSearchIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.SearchResults])
+	ret := m.ctrl.Call(m, "GetSuspendedTicketsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.SuspendedTicket])
 	return ret0
 }
 
-// GetSearchIterator indicates an expected call of GetSearchIterator.
-func (mr *ClientMockRecorder) GetSearchIterator(ctx, opts any) *gomock.Call {
+// GetSuspendedTicketsIterator indicates an expected call of GetSuspendedTicketsIterator.
+func (mr *ClientMockRecorder) GetSuspendedTicketsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchIterator", reflect.TypeOf((*Client)(nil).GetSearchIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuspendedTicketsIterator", reflect.TypeOf((*Client)(nil).GetSuspendedTicketsIterator), ctx, opts)
 }
 
-// GetSearchOBP mocks base method.
-func (m *Client) GetSearchOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.SearchResults, zendesk.Page, error) {
+// GetSuspendedTicketsOBP mocks base method.
+func (m *Client) GetSuspendedTicketsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.SuspendedTicket, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSearchOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.SearchResults)
+	ret := m.ctrl.Call(m, "GetSuspendedTicketsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SuspendedTicket)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetSearchOBP indicates an expected call of GetSearchOBP.
-func (mr *ClientMockRecorder) GetSearchOBP(ctx, opts any) *gomock.Call {
+// GetSuspendedTicketsOBP indicates an expected call of GetSuspendedTicketsOBP.
+func (mr *ClientMockRecorder) GetSuspendedTicketsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchOBP", reflect.TypeOf((*Client)(nil).GetSearchOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuspendedTicketsOBP", reflect.TypeOf((*Client)(nil).GetSuspendedTicketsOBP), ctx, opts)
 }
 
 // GetTarget mocks base method.
@@ -1719,6 +3408,21 @@ func (mr *ClientMockRecorder) GetTicketAuditsOBP(ctx, opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAuditsOBP", reflect.TypeOf((*Client)(nil).GetTicketAuditsOBP), ctx, opts)
 }
 
+// GetTicketCollaborators mocks base method.
+func (m *Client) GetTicketCollaborators(ctx context.Context, ticketID int64) ([]zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketCollaborators", ctx, ticketID)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketCollaborators indicates an expected call of GetTicketCollaborators.
+func (mr *ClientMockRecorder) GetTicketCollaborators(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketCollaborators", reflect.TypeOf((*Client)(nil).GetTicketCollaborators), ctx, ticketID)
+}
+
 // GetTicketCommentsCBP mocks base method.
 func (m *Client) GetTicketCommentsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.TicketComment, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
@@ -1765,6 +3469,21 @@ func (mr *ClientMockRecorder) GetTicketCommentsOBP(ctx, opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketCommentsOBP", reflect.TypeOf((*Client)(nil).GetTicketCommentsOBP), ctx, opts)
 }
 
+// GetTicketEmailCCs mocks base method.
+func (m *Client) GetTicketEmailCCs(ctx context.Context, ticketID int64) ([]zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketEmailCCs", ctx, ticketID)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketEmailCCs indicates an expected call of GetTicketEmailCCs.
+func (mr *ClientMockRecorder) GetTicketEmailCCs(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketEmailCCs", reflect.TypeOf((*Client)(nil).GetTicketEmailCCs), ctx, ticketID)
+}
+
 // GetTicketField mocks base method.
 func (m *Client) GetTicketField(ctx context.Context, ticketID int64) (zendesk.TicketField, error) {
 	m.ctrl.T.Helper()
@@ -1829,94 +3548,340 @@ func (mr *ClientMockRecorder) GetTicketFieldsIterator(ctx, opts any) *gomock.Cal
 // GetTicketFieldsOBP mocks base method.
 func (m *Client) GetTicketFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.TicketField, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketFieldsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.TicketField)
+	ret := m.ctrl.Call(m, "GetTicketFieldsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketFieldsOBP indicates an expected call of GetTicketFieldsOBP.
+func (mr *ClientMockRecorder) GetTicketFieldsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFieldsOBP", reflect.TypeOf((*Client)(nil).GetTicketFieldsOBP), ctx, opts)
+}
+
+// GetTicketFollowers mocks base method.
+func (m *Client) GetTicketFollowers(ctx context.Context, ticketID int64) ([]zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFollowers", ctx, ticketID)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketFollowers indicates an expected call of GetTicketFollowers.
+func (mr *ClientMockRecorder) GetTicketFollowers(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFollowers", reflect.TypeOf((*Client)(nil).GetTicketFollowers), ctx, ticketID)
+}
+
+// GetTicketForm mocks base method.
+func (m *Client) GetTicketForm(ctx context.Context, id int64) (zendesk.TicketForm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketForm", ctx, id)
+	ret0, _ := ret[0].(zendesk.TicketForm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketForm indicates an expected call of GetTicketForm.
+func (mr *ClientMockRecorder) GetTicketForm(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketForm", reflect.TypeOf((*Client)(nil).GetTicketForm), ctx, id)
+}
+
+// GetTicketForms mocks base method.
+func (m *Client) GetTicketForms(ctx context.Context, options *zendesk.TicketFormListOptions) ([]zendesk.TicketForm, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketForms", ctx, options)
+	ret0, _ := ret[0].([]zendesk.TicketForm)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketForms indicates an expected call of GetTicketForms.
+func (mr *ClientMockRecorder) GetTicketForms(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketForms", reflect.TypeOf((*Client)(nil).GetTicketForms), ctx, options)
+}
+
+// GetTicketFormsCBP mocks base method.
+func (m *Client) GetTicketFormsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.TicketForm, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFormsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketForm)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketFormsCBP indicates an expected call of GetTicketFormsCBP.
+func (mr *ClientMockRecorder) GetTicketFormsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFormsCBP", reflect.TypeOf((*Client)(nil).GetTicketFormsCBP), ctx, opts)
+}
+
+// GetTicketFormsIterator mocks base method.
+func (m *Client) GetTicketFormsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.TicketForm] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFormsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.TicketForm])
+	return ret0
+}
+
+// GetTicketFormsIterator indicates an expected call of GetTicketFormsIterator.
+func (mr *ClientMockRecorder) GetTicketFormsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFormsIterator", reflect.TypeOf((*Client)(nil).GetTicketFormsIterator), ctx, opts)
+}
+
+// GetTicketFormsOBP mocks base method.
+func (m *Client) GetTicketFormsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.TicketForm, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFormsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketForm)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketFormsOBP indicates an expected call of GetTicketFormsOBP.
+func (mr *ClientMockRecorder) GetTicketFormsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFormsOBP", reflect.TypeOf((*Client)(nil).GetTicketFormsOBP), ctx, opts)
+}
+
+// GetTicketIncidents mocks base method.
+func (m *Client) GetTicketIncidents(ctx context.Context, ticketID int64, opts *zendesk.PageOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketIncidents", ctx, ticketID, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketIncidents indicates an expected call of GetTicketIncidents.
+func (mr *ClientMockRecorder) GetTicketIncidents(ctx, ticketID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketIncidents", reflect.TypeOf((*Client)(nil).GetTicketIncidents), ctx, ticketID, opts)
+}
+
+// GetTicketIncidentsCBP mocks base method.
+func (m *Client) GetTicketIncidentsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketIncidentsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketIncidentsCBP indicates an expected call of GetTicketIncidentsCBP.
+func (mr *ClientMockRecorder) GetTicketIncidentsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketIncidentsCBP", reflect.TypeOf((*Client)(nil).GetTicketIncidentsCBP), ctx, opts)
+}
+
+// GetTicketIncidentsIterator mocks base method.
+func (m *Client) GetTicketIncidentsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Ticket] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketIncidentsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Ticket])
+	return ret0
+}
+
+// GetTicketIncidentsIterator indicates an expected call of GetTicketIncidentsIterator.
+func (mr *ClientMockRecorder) GetTicketIncidentsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketIncidentsIterator", reflect.TypeOf((*Client)(nil).GetTicketIncidentsIterator), ctx, opts)
+}
+
+// GetTicketIncidentsOBP mocks base method.
+func (m *Client) GetTicketIncidentsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketIncidentsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketIncidentsOBP indicates an expected call of GetTicketIncidentsOBP.
+func (mr *ClientMockRecorder) GetTicketIncidentsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketIncidentsOBP", reflect.TypeOf((*Client)(nil).GetTicketIncidentsOBP), ctx, opts)
+}
+
+// GetTicketMetric mocks base method.
+func (m *Client) GetTicketMetric(ctx context.Context, ticketMetricsID int64) (zendesk.TicketMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketMetric", ctx, ticketMetricsID)
+	ret0, _ := ret[0].(zendesk.TicketMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketMetric indicates an expected call of GetTicketMetric.
+func (mr *ClientMockRecorder) GetTicketMetric(ctx, ticketMetricsID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketMetric", reflect.TypeOf((*Client)(nil).GetTicketMetric), ctx, ticketMetricsID)
+}
+
+// GetTicketMetricByTicket mocks base method.
+func (m *Client) GetTicketMetricByTicket(ctx context.Context, ticketID int64) (zendesk.TicketMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketMetricByTicket", ctx, ticketID)
+	ret0, _ := ret[0].(zendesk.TicketMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketMetricByTicket indicates an expected call of GetTicketMetricByTicket.
+func (mr *ClientMockRecorder) GetTicketMetricByTicket(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketMetricByTicket", reflect.TypeOf((*Client)(nil).GetTicketMetricByTicket), ctx, ticketID)
+}
+
+// GetTicketMetrics mocks base method.
+func (m *Client) GetTicketMetrics(ctx context.Context, opts *zendesk.TicketMetricListOptions) ([]zendesk.TicketMetric, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketMetrics", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketMetric)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketMetrics indicates an expected call of GetTicketMetrics.
+func (mr *ClientMockRecorder) GetTicketMetrics(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketMetrics", reflect.TypeOf((*Client)(nil).GetTicketMetrics), ctx, opts)
+}
+
+// GetTicketMetricsCBP mocks base method.
+func (m *Client) GetTicketMetricsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.TicketMetric, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketMetricsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketMetric)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketMetricsCBP indicates an expected call of GetTicketMetricsCBP.
+func (mr *ClientMockRecorder) GetTicketMetricsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketMetricsCBP", reflect.TypeOf((*Client)(nil).GetTicketMetricsCBP), ctx, opts)
+}
+
+// GetTicketMetricsIterator mocks base method.
+func (m *Client) GetTicketMetricsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.TicketMetric] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketMetricsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.TicketMetric])
+	return ret0
+}
+
+// GetTicketMetricsIterator indicates an expected call of GetTicketMetricsIterator.
+func (mr *ClientMockRecorder) GetTicketMetricsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketMetricsIterator", reflect.TypeOf((*Client)(nil).GetTicketMetricsIterator), ctx, opts)
+}
+
+// GetTicketMetricsOBP mocks base method.
+func (m *Client) GetTicketMetricsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.TicketMetric, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketMetricsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketMetric)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetTicketFieldsOBP indicates an expected call of GetTicketFieldsOBP.
-func (mr *ClientMockRecorder) GetTicketFieldsOBP(ctx, opts any) *gomock.Call {
+// GetTicketMetricsOBP indicates an expected call of GetTicketMetricsOBP.
+func (mr *ClientMockRecorder) GetTicketMetricsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFieldsOBP", reflect.TypeOf((*Client)(nil).GetTicketFieldsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketMetricsOBP", reflect.TypeOf((*Client)(nil).GetTicketMetricsOBP), ctx, opts)
 }
 
-// GetTicketForm mocks base method.
-func (m *Client) GetTicketForm(ctx context.Context, id int64) (zendesk.TicketForm, error) {
+// GetTicketRelated mocks base method.
+func (m *Client) GetTicketRelated(ctx context.Context, ticketID int64) (zendesk.TicketRelated, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketForm", ctx, id)
-	ret0, _ := ret[0].(zendesk.TicketForm)
+	ret := m.ctrl.Call(m, "GetTicketRelated", ctx, ticketID)
+	ret0, _ := ret[0].(zendesk.TicketRelated)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTicketForm indicates an expected call of GetTicketForm.
-func (mr *ClientMockRecorder) GetTicketForm(ctx, id any) *gomock.Call {
+// GetTicketRelated indicates an expected call of GetTicketRelated.
+func (mr *ClientMockRecorder) GetTicketRelated(ctx, ticketID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketForm", reflect.TypeOf((*Client)(nil).GetTicketForm), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketRelated", reflect.TypeOf((*Client)(nil).GetTicketRelated), ctx, ticketID)
 }
 
-// GetTicketForms mocks base method.
-func (m *Client) GetTicketForms(ctx context.Context, options *zendesk.TicketFormListOptions) ([]zendesk.TicketForm, zendesk.Page, error) {
+// GetTicketSkips mocks base method.
+func (m *Client) GetTicketSkips(ctx context.Context, ticketID int64, opts *zendesk.SkipListOptions) ([]zendesk.Skip, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketForms", ctx, options)
-	ret0, _ := ret[0].([]zendesk.TicketForm)
+	ret := m.ctrl.Call(m, "GetTicketSkips", ctx, ticketID, opts)
+	ret0, _ := ret[0].([]zendesk.Skip)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetTicketForms indicates an expected call of GetTicketForms.
-func (mr *ClientMockRecorder) GetTicketForms(ctx, options any) *gomock.Call {
+// GetTicketSkips indicates an expected call of GetTicketSkips.
+func (mr *ClientMockRecorder) GetTicketSkips(ctx, ticketID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketForms", reflect.TypeOf((*Client)(nil).GetTicketForms), ctx, options)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketSkips", reflect.TypeOf((*Client)(nil).GetTicketSkips), ctx, ticketID, opts)
 }
 
-// GetTicketFormsCBP mocks base method.
-func (m *Client) GetTicketFormsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.TicketForm, zendesk.CursorPaginationMeta, error) {
+// GetTicketSkipsCBP mocks base method.
+func (m *Client) GetTicketSkipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Skip, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketFormsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.TicketForm)
+	ret := m.ctrl.Call(m, "GetTicketSkipsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Skip)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetTicketFormsCBP indicates an expected call of GetTicketFormsCBP.
-func (mr *ClientMockRecorder) GetTicketFormsCBP(ctx, opts any) *gomock.Call {
+// GetTicketSkipsCBP indicates an expected call of GetTicketSkipsCBP.
+func (mr *ClientMockRecorder) GetTicketSkipsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFormsCBP", reflect.TypeOf((*Client)(nil).GetTicketFormsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketSkipsCBP", reflect.TypeOf((*Client)(nil).GetTicketSkipsCBP), ctx, opts)
 }
 
-// GetTicketFormsIterator mocks base method.
-func (m *Client) GetTicketFormsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.TicketForm] {
+// GetTicketSkipsIterator mocks base method.
+func (m *Client) GetTicketSkipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Skip] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketFormsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.TicketForm])
+	ret := m.ctrl.Call(m, "GetTicketSkipsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Skip])
 	return ret0
 }
 
-// GetTicketFormsIterator indicates an expected call of GetTicketFormsIterator.
-func (mr *ClientMockRecorder) GetTicketFormsIterator(ctx, opts any) *gomock.Call {
+// GetTicketSkipsIterator indicates an expected call of GetTicketSkipsIterator.
+func (mr *ClientMockRecorder) GetTicketSkipsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFormsIterator", reflect.TypeOf((*Client)(nil).GetTicketFormsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketSkipsIterator", reflect.TypeOf((*Client)(nil).GetTicketSkipsIterator), ctx, opts)
 }
 
-// GetTicketFormsOBP mocks base method.
-func (m *Client) GetTicketFormsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.TicketForm, zendesk.Page, error) {
+// GetTicketSkipsOBP mocks base method.
+func (m *Client) GetTicketSkipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Skip, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketFormsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.TicketForm)
+	ret := m.ctrl.Call(m, "GetTicketSkipsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Skip)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetTicketFormsOBP indicates an expected call of GetTicketFormsOBP.
-func (mr *ClientMockRecorder) GetTicketFormsOBP(ctx, opts any) *gomock.Call {
+// GetTicketSkipsOBP indicates an expected call of GetTicketSkipsOBP.
+func (mr *ClientMockRecorder) GetTicketSkipsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFormsOBP", reflect.TypeOf((*Client)(nil).GetTicketFormsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketSkipsOBP", reflect.TypeOf((*Client)(nil).GetTicketSkipsOBP), ctx, opts)
 }
 
 // GetTicketTags mocks base method.
@@ -2052,179 +4017,410 @@ func (m *Client) GetTicketsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([
 	return ret0, ret1, ret2
 }
 
-// GetTicketsOBP indicates an expected call of GetTicketsOBP.
-func (mr *ClientMockRecorder) GetTicketsOBP(ctx, opts any) *gomock.Call {
+// GetTicketsOBP indicates an expected call of GetTicketsOBP.
+func (mr *ClientMockRecorder) GetTicketsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsOBP", reflect.TypeOf((*Client)(nil).GetTicketsOBP), ctx, opts)
+}
+
+// GetTrigger mocks base method.
+func (m *Client) GetTrigger(ctx context.Context, id int64) (zendesk.Trigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrigger", ctx, id)
+	ret0, _ := ret[0].(zendesk.Trigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrigger indicates an expected call of GetTrigger.
+func (mr *ClientMockRecorder) GetTrigger(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrigger", reflect.TypeOf((*Client)(nil).GetTrigger), ctx, id)
+}
+
+// GetTriggers mocks base method.
+func (m *Client) GetTriggers(ctx context.Context, opts *zendesk.TriggerListOptions) ([]zendesk.Trigger, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTriggers indicates an expected call of GetTriggers.
+func (mr *ClientMockRecorder) GetTriggers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggers", reflect.TypeOf((*Client)(nil).GetTriggers), ctx, opts)
+}
+
+// GetTriggersCBP mocks base method.
+func (m *Client) GetTriggersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Trigger, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggersCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTriggersCBP indicates an expected call of GetTriggersCBP.
+func (mr *ClientMockRecorder) GetTriggersCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggersCBP", reflect.TypeOf((*Client)(nil).GetTriggersCBP), ctx, opts)
+}
+
+// GetTriggersIterator mocks base method.
+func (m *Client) GetTriggersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Trigger] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggersIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Trigger])
+	return ret0
+}
+
+// GetTriggersIterator indicates an expected call of GetTriggersIterator.
+func (mr *ClientMockRecorder) GetTriggersIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggersIterator", reflect.TypeOf((*Client)(nil).GetTriggersIterator), ctx, opts)
+}
+
+// GetTriggersOBP mocks base method.
+func (m *Client) GetTriggersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Trigger, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggersOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTriggersOBP indicates an expected call of GetTriggersOBP.
+func (mr *ClientMockRecorder) GetTriggersOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggersOBP", reflect.TypeOf((*Client)(nil).GetTriggersOBP), ctx, opts)
+}
+
+// GetUser mocks base method.
+func (m *Client) GetUser(ctx context.Context, userID int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *ClientMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*Client)(nil).GetUser), ctx, userID)
+}
+
+// GetUserFields mocks base method.
+func (m *Client) GetUserFields(ctx context.Context, opts *zendesk.UserFieldListOptions) ([]zendesk.UserField, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFields", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.UserField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserFields indicates an expected call of GetUserFields.
+func (mr *ClientMockRecorder) GetUserFields(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFields", reflect.TypeOf((*Client)(nil).GetUserFields), ctx, opts)
+}
+
+// GetUserFieldsCBP mocks base method.
+func (m *Client) GetUserFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.UserField, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.UserField)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserFieldsCBP indicates an expected call of GetUserFieldsCBP.
+func (mr *ClientMockRecorder) GetUserFieldsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsCBP", reflect.TypeOf((*Client)(nil).GetUserFieldsCBP), ctx, opts)
+}
+
+// GetUserFieldsIterator mocks base method.
+func (m *Client) GetUserFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.UserField] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.UserField])
+	return ret0
+}
+
+// GetUserFieldsIterator indicates an expected call of GetUserFieldsIterator.
+func (mr *ClientMockRecorder) GetUserFieldsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsIterator", reflect.TypeOf((*Client)(nil).GetUserFieldsIterator), ctx, opts)
+}
+
+// GetUserFieldsOBP mocks base method.
+func (m *Client) GetUserFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.UserField, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.UserField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserFieldsOBP indicates an expected call of GetUserFieldsOBP.
+func (mr *ClientMockRecorder) GetUserFieldsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsOBP", reflect.TypeOf((*Client)(nil).GetUserFieldsOBP), ctx, opts)
+}
+
+// GetUserIdentities mocks base method.
+func (m *Client) GetUserIdentities(ctx context.Context, userID int64, opts *zendesk.PageOptions) ([]zendesk.UserIdentity, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentities", ctx, userID, opts)
+	ret0, _ := ret[0].([]zendesk.UserIdentity)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserIdentities indicates an expected call of GetUserIdentities.
+func (mr *ClientMockRecorder) GetUserIdentities(ctx, userID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentities", reflect.TypeOf((*Client)(nil).GetUserIdentities), ctx, userID, opts)
+}
+
+// GetUserIdentitiesCBP mocks base method.
+func (m *Client) GetUserIdentitiesCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.UserIdentity, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentitiesCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.UserIdentity)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserIdentitiesCBP indicates an expected call of GetUserIdentitiesCBP.
+func (mr *ClientMockRecorder) GetUserIdentitiesCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentitiesCBP", reflect.TypeOf((*Client)(nil).GetUserIdentitiesCBP), ctx, opts)
+}
+
+// GetUserIdentitiesIterator mocks base method.
+func (m *Client) GetUserIdentitiesIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.UserIdentity] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentitiesIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.UserIdentity])
+	return ret0
+}
+
+// GetUserIdentitiesIterator indicates an expected call of GetUserIdentitiesIterator.
+func (mr *ClientMockRecorder) GetUserIdentitiesIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentitiesIterator", reflect.TypeOf((*Client)(nil).GetUserIdentitiesIterator), ctx, opts)
+}
+
+// GetUserIdentitiesOBP mocks base method.
+func (m *Client) GetUserIdentitiesOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.UserIdentity, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentitiesOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.UserIdentity)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserIdentitiesOBP indicates an expected call of GetUserIdentitiesOBP.
+func (mr *ClientMockRecorder) GetUserIdentitiesOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentitiesOBP", reflect.TypeOf((*Client)(nil).GetUserIdentitiesOBP), ctx, opts)
+}
+
+// GetUserIdentity mocks base method.
+func (m *Client) GetUserIdentity(ctx context.Context, userID, identityID int64) (zendesk.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentity", ctx, userID, identityID)
+	ret0, _ := ret[0].(zendesk.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIdentity indicates an expected call of GetUserIdentity.
+func (mr *ClientMockRecorder) GetUserIdentity(ctx, userID, identityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentity", reflect.TypeOf((*Client)(nil).GetUserIdentity), ctx, userID, identityID)
+}
+
+// GetUserPasswordRequirements mocks base method.
+func (m *Client) GetUserPasswordRequirements(ctx context.Context, userID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPasswordRequirements", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPasswordRequirements indicates an expected call of GetUserPasswordRequirements.
+func (mr *ClientMockRecorder) GetUserPasswordRequirements(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPasswordRequirements", reflect.TypeOf((*Client)(nil).GetUserPasswordRequirements), ctx, userID)
+}
+
+// GetUserRelated mocks base method.
+func (m *Client) GetUserRelated(ctx context.Context, userID int64) (zendesk.UserRelated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRelated", ctx, userID)
+	ret0, _ := ret[0].(zendesk.UserRelated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRelated indicates an expected call of GetUserRelated.
+func (mr *ClientMockRecorder) GetUserRelated(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsOBP", reflect.TypeOf((*Client)(nil).GetTicketsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRelated", reflect.TypeOf((*Client)(nil).GetUserRelated), ctx, userID)
 }
 
-// GetTrigger mocks base method.
-func (m *Client) GetTrigger(ctx context.Context, id int64) (zendesk.Trigger, error) {
+// GetUserSession mocks base method.
+func (m *Client) GetUserSession(ctx context.Context, userID, sessionID int64) (zendesk.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTrigger", ctx, id)
-	ret0, _ := ret[0].(zendesk.Trigger)
+	ret := m.ctrl.Call(m, "GetUserSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(zendesk.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTrigger indicates an expected call of GetTrigger.
-func (mr *ClientMockRecorder) GetTrigger(ctx, id any) *gomock.Call {
+// GetUserSession indicates an expected call of GetUserSession.
+func (mr *ClientMockRecorder) GetUserSession(ctx, userID, sessionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrigger", reflect.TypeOf((*Client)(nil).GetTrigger), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSession", reflect.TypeOf((*Client)(nil).GetUserSession), ctx, userID, sessionID)
 }
 
-// GetTriggers mocks base method.
-func (m *Client) GetTriggers(ctx context.Context, opts *zendesk.TriggerListOptions) ([]zendesk.Trigger, zendesk.Page, error) {
+// GetUserSessions mocks base method.
+func (m *Client) GetUserSessions(ctx context.Context, userID int64, opts *zendesk.PageOptions) ([]zendesk.Session, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTriggers", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret := m.ctrl.Call(m, "GetUserSessions", ctx, userID, opts)
+	ret0, _ := ret[0].([]zendesk.Session)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetTriggers indicates an expected call of GetTriggers.
-func (mr *ClientMockRecorder) GetTriggers(ctx, opts any) *gomock.Call {
+// GetUserSessions indicates an expected call of GetUserSessions.
+func (mr *ClientMockRecorder) GetUserSessions(ctx, userID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggers", reflect.TypeOf((*Client)(nil).GetTriggers), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessions", reflect.TypeOf((*Client)(nil).GetUserSessions), ctx, userID, opts)
 }
 
-// GetTriggersCBP mocks base method.
-func (m *Client) GetTriggersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Trigger, zendesk.CursorPaginationMeta, error) {
+// GetUserSessionsCBP mocks base method.
+func (m *Client) GetUserSessionsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Session, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTriggersCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret := m.ctrl.Call(m, "GetUserSessionsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Session)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetTriggersCBP indicates an expected call of GetTriggersCBP.
-func (mr *ClientMockRecorder) GetTriggersCBP(ctx, opts any) *gomock.Call {
+// GetUserSessionsCBP indicates an expected call of GetUserSessionsCBP.
+func (mr *ClientMockRecorder) GetUserSessionsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggersCBP", reflect.TypeOf((*Client)(nil).GetTriggersCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessionsCBP", reflect.TypeOf((*Client)(nil).GetUserSessionsCBP), ctx, opts)
 }
 
-// GetTriggersIterator mocks base method.
-func (m *Client) GetTriggersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Trigger] {
+// GetUserSessionsIterator mocks base method.
+func (m *Client) GetUserSessionsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Session] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTriggersIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Trigger])
+	ret := m.ctrl.Call(m, "GetUserSessionsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Session])
 	return ret0
 }
 
-// GetTriggersIterator indicates an expected call of GetTriggersIterator.
-func (mr *ClientMockRecorder) GetTriggersIterator(ctx, opts any) *gomock.Call {
+// GetUserSessionsIterator indicates an expected call of GetUserSessionsIterator.
+func (mr *ClientMockRecorder) GetUserSessionsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggersIterator", reflect.TypeOf((*Client)(nil).GetTriggersIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessionsIterator", reflect.TypeOf((*Client)(nil).GetUserSessionsIterator), ctx, opts)
 }
 
-// GetTriggersOBP mocks base method.
-func (m *Client) GetTriggersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Trigger, zendesk.Page, error) {
+// GetUserSessionsOBP mocks base method.
+func (m *Client) GetUserSessionsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Session, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTriggersOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret := m.ctrl.Call(m, "GetUserSessionsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Session)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetTriggersOBP indicates an expected call of GetTriggersOBP.
-func (mr *ClientMockRecorder) GetTriggersOBP(ctx, opts any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggersOBP", reflect.TypeOf((*Client)(nil).GetTriggersOBP), ctx, opts)
-}
-
-// GetUser mocks base method.
-func (m *Client) GetUser(ctx context.Context, userID int64) (zendesk.User, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
-	ret0, _ := ret[0].(zendesk.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetUser indicates an expected call of GetUser.
-func (mr *ClientMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+// GetUserSessionsOBP indicates an expected call of GetUserSessionsOBP.
+func (mr *ClientMockRecorder) GetUserSessionsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*Client)(nil).GetUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessionsOBP", reflect.TypeOf((*Client)(nil).GetUserSessionsOBP), ctx, opts)
 }
 
-// GetUserFields mocks base method.
-func (m *Client) GetUserFields(ctx context.Context, opts *zendesk.UserFieldListOptions) ([]zendesk.UserField, zendesk.Page, error) {
+// GetUserSkips mocks base method.
+func (m *Client) GetUserSkips(ctx context.Context, userID int64, opts *zendesk.SkipListOptions) ([]zendesk.Skip, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserFields", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.UserField)
+	ret := m.ctrl.Call(m, "GetUserSkips", ctx, userID, opts)
+	ret0, _ := ret[0].([]zendesk.Skip)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetUserFields indicates an expected call of GetUserFields.
-func (mr *ClientMockRecorder) GetUserFields(ctx, opts any) *gomock.Call {
+// GetUserSkips indicates an expected call of GetUserSkips.
+func (mr *ClientMockRecorder) GetUserSkips(ctx, userID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFields", reflect.TypeOf((*Client)(nil).GetUserFields), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSkips", reflect.TypeOf((*Client)(nil).GetUserSkips), ctx, userID, opts)
 }
 
-// GetUserFieldsCBP mocks base method.
-func (m *Client) GetUserFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.UserField, zendesk.CursorPaginationMeta, error) {
+// GetUserSkipsCBP mocks base method.
+func (m *Client) GetUserSkipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Skip, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserFieldsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.UserField)
+	ret := m.ctrl.Call(m, "GetUserSkipsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Skip)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetUserFieldsCBP indicates an expected call of GetUserFieldsCBP.
-func (mr *ClientMockRecorder) GetUserFieldsCBP(ctx, opts any) *gomock.Call {
+// GetUserSkipsCBP indicates an expected call of GetUserSkipsCBP.
+func (mr *ClientMockRecorder) GetUserSkipsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsCBP", reflect.TypeOf((*Client)(nil).GetUserFieldsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSkipsCBP", reflect.TypeOf((*Client)(nil).GetUserSkipsCBP), ctx, opts)
 }
 
-// GetUserFieldsIterator mocks base method.
-func (m *Client) GetUserFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.UserField] {
+// GetUserSkipsIterator mocks base method.
+func (m *Client) GetUserSkipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Skip] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserFieldsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.UserField])
+	ret := m.ctrl.Call(m, "GetUserSkipsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Skip])
 	return ret0
 }
 
-// GetUserFieldsIterator indicates an expected call of GetUserFieldsIterator.
-func (mr *ClientMockRecorder) GetUserFieldsIterator(ctx, opts any) *gomock.Call {
+// GetUserSkipsIterator indicates an expected call of GetUserSkipsIterator.
+func (mr *ClientMockRecorder) GetUserSkipsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsIterator", reflect.TypeOf((*Client)(nil).GetUserFieldsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSkipsIterator", reflect.TypeOf((*Client)(nil).GetUserSkipsIterator), ctx, opts)
 }
 
-// GetUserFieldsOBP mocks base method.
-func (m *Client) GetUserFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.UserField, zendesk.Page, error) {
+// GetUserSkipsOBP mocks base method.
+func (m *Client) GetUserSkipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Skip, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserFieldsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.UserField)
+	ret := m.ctrl.Call(m, "GetUserSkipsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Skip)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetUserFieldsOBP indicates an expected call of GetUserFieldsOBP.
-func (mr *ClientMockRecorder) GetUserFieldsOBP(ctx, opts any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsOBP", reflect.TypeOf((*Client)(nil).GetUserFieldsOBP), ctx, opts)
-}
-
-// GetUserRelated mocks base method.
-func (m *Client) GetUserRelated(ctx context.Context, userID int64) (zendesk.UserRelated, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserRelated", ctx, userID)
-	ret0, _ := ret[0].(zendesk.UserRelated)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetUserRelated indicates an expected call of GetUserRelated.
-func (mr *ClientMockRecorder) GetUserRelated(ctx, userID any) *gomock.Call {
+// GetUserSkipsOBP indicates an expected call of GetUserSkipsOBP.
+func (mr *ClientMockRecorder) GetUserSkipsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRelated", reflect.TypeOf((*Client)(nil).GetUserRelated), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSkipsOBP", reflect.TypeOf((*Client)(nil).GetUserSkipsOBP), ctx, opts)
 }
 
 // GetUserTags mocks base method.
@@ -2319,6 +4515,21 @@ func (mr *ClientMockRecorder) GetView(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetView", reflect.TypeOf((*Client)(nil).GetView), arg0, arg1)
 }
 
+// GetViewCount mocks base method.
+func (m *Client) GetViewCount(ctx context.Context, viewID int64) (zendesk.ViewCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViewCount", ctx, viewID)
+	ret0, _ := ret[0].(zendesk.ViewCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetViewCount indicates an expected call of GetViewCount.
+func (mr *ClientMockRecorder) GetViewCount(ctx, viewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewCount", reflect.TypeOf((*Client)(nil).GetViewCount), ctx, viewID)
+}
+
 // GetViews mocks base method.
 func (m *Client) GetViews(arg0 context.Context) ([]zendesk.View, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -2411,6 +4622,21 @@ func (mr *ClientMockRecorder) GetWebhookSigningSecret(ctx, webhookID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSigningSecret", reflect.TypeOf((*Client)(nil).GetWebhookSigningSecret), ctx, webhookID)
 }
 
+// LinkIncidentToProblem mocks base method.
+func (m *Client) LinkIncidentToProblem(ctx context.Context, incidentID, problemID int64) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkIncidentToProblem", ctx, incidentID, problemID)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkIncidentToProblem indicates an expected call of LinkIncidentToProblem.
+func (mr *ClientMockRecorder) LinkIncidentToProblem(ctx, incidentID, problemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkIncidentToProblem", reflect.TypeOf((*Client)(nil).LinkIncidentToProblem), ctx, incidentID, problemID)
+}
+
 // ListCustomObjectRecords mocks base method.
 func (m *Client) ListCustomObjectRecords(ctx context.Context, customObjectKey string, opts *zendesk.CustomObjectListOptions) ([]zendesk.CustomObjectRecord, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -2424,81 +4650,272 @@ func (m *Client) ListCustomObjectRecords(ctx context.Context, customObjectKey st
 // ListCustomObjectRecords indicates an expected call of ListCustomObjectRecords.
 func (mr *ClientMockRecorder) ListCustomObjectRecords(ctx, customObjectKey, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomObjectRecords", reflect.TypeOf((*Client)(nil).ListCustomObjectRecords), ctx, customObjectKey, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomObjectRecords", reflect.TypeOf((*Client)(nil).ListCustomObjectRecords), ctx, customObjectKey, opts)
+}
+
+// ListInstallations mocks base method.
+func (m *Client) ListInstallations(ctx context.Context) ([]zendesk.AppInstallation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstallations", ctx)
+	ret0, _ := ret[0].([]zendesk.AppInstallation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstallations indicates an expected call of ListInstallations.
+func (mr *ClientMockRecorder) ListInstallations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstallations", reflect.TypeOf((*Client)(nil).ListInstallations), ctx)
+}
+
+// ListTicketComments mocks base method.
+func (m *Client) ListTicketComments(ctx context.Context, ticketID int64, opts *zendesk.ListTicketCommentsOptions) (*zendesk.ListTicketCommentsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTicketComments", ctx, ticketID, opts)
+	ret0, _ := ret[0].(*zendesk.ListTicketCommentsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTicketComments indicates an expected call of ListTicketComments.
+func (mr *ClientMockRecorder) ListTicketComments(ctx, ticketID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketComments", reflect.TypeOf((*Client)(nil).ListTicketComments), ctx, ticketID, opts)
+}
+
+// LogoutCurrentSession mocks base method.
+func (m *Client) LogoutCurrentSession(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogoutCurrentSession", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogoutCurrentSession indicates an expected call of LogoutCurrentSession.
+func (mr *ClientMockRecorder) LogoutCurrentSession(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutCurrentSession", reflect.TypeOf((*Client)(nil).LogoutCurrentSession), ctx)
+}
+
+// MakeCommentPrivate mocks base method.
+func (m *Client) MakeCommentPrivate(ctx context.Context, ticketID, ticketCommentID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeCommentPrivate", ctx, ticketID, ticketCommentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MakeCommentPrivate indicates an expected call of MakeCommentPrivate.
+func (mr *ClientMockRecorder) MakeCommentPrivate(ctx, ticketID, ticketCommentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeCommentPrivate", reflect.TypeOf((*Client)(nil).MakeCommentPrivate), ctx, ticketID, ticketCommentID)
+}
+
+// MakeUserIdentityPrimary mocks base method.
+func (m *Client) MakeUserIdentityPrimary(ctx context.Context, userID, identityID int64) ([]zendesk.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeUserIdentityPrimary", ctx, userID, identityID)
+	ret0, _ := ret[0].([]zendesk.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MakeUserIdentityPrimary indicates an expected call of MakeUserIdentityPrimary.
+func (mr *ClientMockRecorder) MakeUserIdentityPrimary(ctx, userID, identityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeUserIdentityPrimary", reflect.TypeOf((*Client)(nil).MakeUserIdentityPrimary), ctx, userID, identityID)
+}
+
+// MarkTicketAsSpam mocks base method.
+func (m *Client) MarkTicketAsSpam(ctx context.Context, ticketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkTicketAsSpam", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkTicketAsSpam indicates an expected call of MarkTicketAsSpam.
+func (mr *ClientMockRecorder) MarkTicketAsSpam(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkTicketAsSpam", reflect.TypeOf((*Client)(nil).MarkTicketAsSpam), ctx, ticketID)
+}
+
+// MarkTicketsAsSpam mocks base method.
+func (m *Client) MarkTicketsAsSpam(ctx context.Context, ticketIDs []int64) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkTicketsAsSpam", ctx, ticketIDs)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkTicketsAsSpam indicates an expected call of MarkTicketsAsSpam.
+func (mr *ClientMockRecorder) MarkTicketsAsSpam(ctx, ticketIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkTicketsAsSpam", reflect.TypeOf((*Client)(nil).MarkTicketsAsSpam), ctx, ticketIDs)
+}
+
+// MergeOrganizations mocks base method.
+func (m *Client) MergeOrganizations(ctx context.Context, loserID, winnerID int64) (zendesk.OrganizationMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeOrganizations", ctx, loserID, winnerID)
+	ret0, _ := ret[0].(zendesk.OrganizationMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeOrganizations indicates an expected call of MergeOrganizations.
+func (mr *ClientMockRecorder) MergeOrganizations(ctx, loserID, winnerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeOrganizations", reflect.TypeOf((*Client)(nil).MergeOrganizations), ctx, loserID, winnerID)
+}
+
+// MergeUsers mocks base method.
+func (m *Client) MergeUsers(ctx context.Context, userID, targetUserID int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeUsers", ctx, userID, targetUserID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeUsers indicates an expected call of MergeUsers.
+func (mr *ClientMockRecorder) MergeUsers(ctx, userID, targetUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeUsers", reflect.TypeOf((*Client)(nil).MergeUsers), ctx, userID, targetUserID)
+}
+
+// PermanentlyDeleteUser mocks base method.
+func (m *Client) PermanentlyDeleteUser(ctx context.Context, userID int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PermanentlyDeleteUser", ctx, userID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PermanentlyDeleteUser indicates an expected call of PermanentlyDeleteUser.
+func (mr *ClientMockRecorder) PermanentlyDeleteUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentlyDeleteUser", reflect.TypeOf((*Client)(nil).PermanentlyDeleteUser), ctx, userID)
+}
+
+// Post mocks base method.
+func (m *Client) Post(ctx context.Context, path string, data any) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", ctx, path, data)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Post indicates an expected call of Post.
+func (mr *ClientMockRecorder) Post(ctx, path, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*Client)(nil).Post), ctx, path, data)
+}
+
+// PreviewView mocks base method.
+func (m *Client) PreviewView(ctx context.Context, view zendesk.View, opts *zendesk.ViewExecuteOptions) (zendesk.ViewRows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewView", ctx, view, opts)
+	ret0, _ := ret[0].(zendesk.ViewRows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewView indicates an expected call of PreviewView.
+func (mr *ClientMockRecorder) PreviewView(ctx, view, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewView", reflect.TypeOf((*Client)(nil).PreviewView), ctx, view, opts)
+}
+
+// Put mocks base method.
+func (m *Client) Put(ctx context.Context, path string, data any) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, path, data)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Put indicates an expected call of Put.
+func (mr *ClientMockRecorder) Put(ctx, path, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*Client)(nil).Put), ctx, path, data)
 }
 
-// ListInstallations mocks base method.
-func (m *Client) ListInstallations(ctx context.Context) ([]zendesk.AppInstallation, error) {
+// RecoverSuspendedTicket mocks base method.
+func (m *Client) RecoverSuspendedTicket(ctx context.Context, id int64) (zendesk.Ticket, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListInstallations", ctx)
-	ret0, _ := ret[0].([]zendesk.AppInstallation)
+	ret := m.ctrl.Call(m, "RecoverSuspendedTicket", ctx, id)
+	ret0, _ := ret[0].(zendesk.Ticket)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListInstallations indicates an expected call of ListInstallations.
-func (mr *ClientMockRecorder) ListInstallations(ctx any) *gomock.Call {
+// RecoverSuspendedTicket indicates an expected call of RecoverSuspendedTicket.
+func (mr *ClientMockRecorder) RecoverSuspendedTicket(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstallations", reflect.TypeOf((*Client)(nil).ListInstallations), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverSuspendedTicket", reflect.TypeOf((*Client)(nil).RecoverSuspendedTicket), ctx, id)
 }
 
-// ListTicketComments mocks base method.
-func (m *Client) ListTicketComments(ctx context.Context, ticketID int64, opts *zendesk.ListTicketCommentsOptions) (*zendesk.ListTicketCommentsResult, error) {
+// RecoverSuspendedTickets mocks base method.
+func (m *Client) RecoverSuspendedTickets(ctx context.Context, ids []int64) ([]zendesk.Ticket, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTicketComments", ctx, ticketID, opts)
-	ret0, _ := ret[0].(*zendesk.ListTicketCommentsResult)
+	ret := m.ctrl.Call(m, "RecoverSuspendedTickets", ctx, ids)
+	ret0, _ := ret[0].([]zendesk.Ticket)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTicketComments indicates an expected call of ListTicketComments.
-func (mr *ClientMockRecorder) ListTicketComments(ctx, ticketID, opts any) *gomock.Call {
+// RecoverSuspendedTickets indicates an expected call of RecoverSuspendedTickets.
+func (mr *ClientMockRecorder) RecoverSuspendedTickets(ctx, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketComments", reflect.TypeOf((*Client)(nil).ListTicketComments), ctx, ticketID, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverSuspendedTickets", reflect.TypeOf((*Client)(nil).RecoverSuspendedTickets), ctx, ids)
 }
 
-// MakeCommentPrivate mocks base method.
-func (m *Client) MakeCommentPrivate(ctx context.Context, ticketID, ticketCommentID int64) error {
+// ReorderSLAPolicies mocks base method.
+func (m *Client) ReorderSLAPolicies(ctx context.Context, ids []int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MakeCommentPrivate", ctx, ticketID, ticketCommentID)
+	ret := m.ctrl.Call(m, "ReorderSLAPolicies", ctx, ids)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MakeCommentPrivate indicates an expected call of MakeCommentPrivate.
-func (mr *ClientMockRecorder) MakeCommentPrivate(ctx, ticketID, ticketCommentID any) *gomock.Call {
+// ReorderSLAPolicies indicates an expected call of ReorderSLAPolicies.
+func (mr *ClientMockRecorder) ReorderSLAPolicies(ctx, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeCommentPrivate", reflect.TypeOf((*Client)(nil).MakeCommentPrivate), ctx, ticketID, ticketCommentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderSLAPolicies", reflect.TypeOf((*Client)(nil).ReorderSLAPolicies), ctx, ids)
 }
 
-// Post mocks base method.
-func (m *Client) Post(ctx context.Context, path string, data any) ([]byte, error) {
+// ReplySideConversation mocks base method.
+func (m *Client) ReplySideConversation(ctx context.Context, ticketID int64, id string, message zendesk.SideConversationMessage) (zendesk.SideConversationEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Post", ctx, path, data)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "ReplySideConversation", ctx, ticketID, id, message)
+	ret0, _ := ret[0].(zendesk.SideConversationEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Post indicates an expected call of Post.
-func (mr *ClientMockRecorder) Post(ctx, path, data any) *gomock.Call {
+// ReplySideConversation indicates an expected call of ReplySideConversation.
+func (mr *ClientMockRecorder) ReplySideConversation(ctx, ticketID, id, message any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*Client)(nil).Post), ctx, path, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplySideConversation", reflect.TypeOf((*Client)(nil).ReplySideConversation), ctx, ticketID, id, message)
 }
 
-// Put mocks base method.
-func (m *Client) Put(ctx context.Context, path string, data any) ([]byte, error) {
+// RequestUserIdentityVerification mocks base method.
+func (m *Client) RequestUserIdentityVerification(ctx context.Context, userID, identityID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Put", ctx, path, data)
-	ret0, _ := ret[0].([]byte)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RequestUserIdentityVerification", ctx, userID, identityID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Put indicates an expected call of Put.
-func (mr *ClientMockRecorder) Put(ctx, path, data any) *gomock.Call {
+// RequestUserIdentityVerification indicates an expected call of RequestUserIdentityVerification.
+func (mr *ClientMockRecorder) RequestUserIdentityVerification(ctx, userID, identityID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*Client)(nil).Put), ctx, path, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestUserIdentityVerification", reflect.TypeOf((*Client)(nil).RequestUserIdentityVerification), ctx, userID, identityID)
 }
 
 // Search mocks base method.
@@ -2548,6 +4965,37 @@ func (mr *ClientMockRecorder) SearchCustomObjectRecords(ctx, customObjectKey, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCustomObjectRecords", reflect.TypeOf((*Client)(nil).SearchCustomObjectRecords), ctx, customObjectKey, opts)
 }
 
+// SearchOrganizationsByName mocks base method.
+func (m *Client) SearchOrganizationsByName(ctx context.Context, name string) ([]zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchOrganizationsByName", ctx, name)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchOrganizationsByName indicates an expected call of SearchOrganizationsByName.
+func (mr *ClientMockRecorder) SearchOrganizationsByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchOrganizationsByName", reflect.TypeOf((*Client)(nil).SearchOrganizationsByName), ctx, name)
+}
+
+// SearchRequests mocks base method.
+func (m *Client) SearchRequests(ctx context.Context, opts *zendesk.SearchRequestsOptions) ([]zendesk.Request, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchRequests", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Request)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchRequests indicates an expected call of SearchRequests.
+func (mr *ClientMockRecorder) SearchRequests(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchRequests", reflect.TypeOf((*Client)(nil).SearchRequests), ctx, opts)
+}
+
 // SearchUsers mocks base method.
 func (m *Client) SearchUsers(ctx context.Context, opts *zendesk.SearchUsersOptions) ([]zendesk.User, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -2564,6 +5012,51 @@ func (mr *ClientMockRecorder) SearchUsers(ctx, opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*Client)(nil).SearchUsers), ctx, opts)
 }
 
+// SearchViews mocks base method.
+func (m *Client) SearchViews(ctx context.Context, opts *zendesk.ViewSearchOptions) ([]zendesk.View, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchViews", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.View)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchViews indicates an expected call of SearchViews.
+func (mr *ClientMockRecorder) SearchViews(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchViews", reflect.TypeOf((*Client)(nil).SearchViews), ctx, opts)
+}
+
+// SetDefaultCustomStatuses mocks base method.
+func (m *Client) SetDefaultCustomStatuses(ctx context.Context, ids []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultCustomStatuses", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultCustomStatuses indicates an expected call of SetDefaultCustomStatuses.
+func (mr *ClientMockRecorder) SetDefaultCustomStatuses(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultCustomStatuses", reflect.TypeOf((*Client)(nil).SetDefaultCustomStatuses), ctx, ids)
+}
+
+// SetDefaultGroupMembership mocks base method.
+func (m *Client) SetDefaultGroupMembership(ctx context.Context, userID, membershipID int64) ([]zendesk.GroupMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultGroupMembership", ctx, userID, membershipID)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDefaultGroupMembership indicates an expected call of SetDefaultGroupMembership.
+func (mr *ClientMockRecorder) SetDefaultGroupMembership(ctx, userID, membershipID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultGroupMembership", reflect.TypeOf((*Client)(nil).SetDefaultGroupMembership), ctx, userID, membershipID)
+}
+
 // SetDefaultOrganization mocks base method.
 func (m *Client) SetDefaultOrganization(arg0 context.Context, arg1 zendesk.OrganizationMembershipOptions) (zendesk.OrganizationMembership, error) {
 	m.ctrl.T.Helper()
@@ -2579,6 +5072,20 @@ func (mr *ClientMockRecorder) SetDefaultOrganization(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultOrganization", reflect.TypeOf((*Client)(nil).SetDefaultOrganization), arg0, arg1)
 }
 
+// SetUserPassword mocks base method.
+func (m *Client) SetUserPassword(ctx context.Context, userID int64, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserPassword", ctx, userID, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserPassword indicates an expected call of SetUserPassword.
+func (mr *ClientMockRecorder) SetUserPassword(ctx, userID, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserPassword", reflect.TypeOf((*Client)(nil).SetUserPassword), ctx, userID, password)
+}
+
 // ShowCustomObjectRecord mocks base method.
 func (m *Client) ShowCustomObjectRecord(ctx context.Context, customObjectKey, customObjectRecordID string) (*zendesk.CustomObjectRecord, error) {
 	m.ctrl.T.Helper()
@@ -2594,6 +5101,36 @@ func (mr *ClientMockRecorder) ShowCustomObjectRecord(ctx, customObjectKey, custo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowCustomObjectRecord", reflect.TypeOf((*Client)(nil).ShowCustomObjectRecord), ctx, customObjectKey, customObjectRecordID)
 }
 
+// SuspendUser mocks base method.
+func (m *Client) SuspendUser(ctx context.Context, userID int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendUser", ctx, userID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *ClientMockRecorder) SuspendUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*Client)(nil).SuspendUser), ctx, userID)
+}
+
+// UnsuspendUser mocks base method.
+func (m *Client) UnsuspendUser(ctx context.Context, userID int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsuspendUser", ctx, userID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsuspendUser indicates an expected call of UnsuspendUser.
+func (mr *ClientMockRecorder) UnsuspendUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsuspendUser", reflect.TypeOf((*Client)(nil).UnsuspendUser), ctx, userID)
+}
+
 // UpdateAutomation mocks base method.
 func (m *Client) UpdateAutomation(ctx context.Context, id int64, automation zendesk.Automation) (zendesk.Automation, error) {
 	m.ctrl.T.Helper()
@@ -2639,6 +5176,36 @@ func (mr *ClientMockRecorder) UpdateCustomObjectRecord(ctx, customObjectKey, cus
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomObjectRecord", reflect.TypeOf((*Client)(nil).UpdateCustomObjectRecord), ctx, customObjectKey, customObjectRecordID, record)
 }
 
+// UpdateCustomRole mocks base method.
+func (m *Client) UpdateCustomRole(ctx context.Context, roleID int64, role zendesk.CustomRole) (zendesk.CustomRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomRole", ctx, roleID, role)
+	ret0, _ := ret[0].(zendesk.CustomRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomRole indicates an expected call of UpdateCustomRole.
+func (mr *ClientMockRecorder) UpdateCustomRole(ctx, roleID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRole", reflect.TypeOf((*Client)(nil).UpdateCustomRole), ctx, roleID, role)
+}
+
+// UpdateCustomStatus mocks base method.
+func (m *Client) UpdateCustomStatus(ctx context.Context, id int64, status zendesk.CustomStatus) (zendesk.CustomStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomStatus", ctx, id, status)
+	ret0, _ := ret[0].(zendesk.CustomStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomStatus indicates an expected call of UpdateCustomStatus.
+func (mr *ClientMockRecorder) UpdateCustomStatus(ctx, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomStatus", reflect.TypeOf((*Client)(nil).UpdateCustomStatus), ctx, id, status)
+}
+
 // UpdateDynamicContentItem mocks base method.
 func (m *Client) UpdateDynamicContentItem(ctx context.Context, id int64, item zendesk.DynamicContentItem) (zendesk.DynamicContentItem, error) {
 	m.ctrl.T.Helper()
@@ -2684,6 +5251,51 @@ func (mr *ClientMockRecorder) UpdateMacro(ctx, macroID, macro any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMacro", reflect.TypeOf((*Client)(nil).UpdateMacro), ctx, macroID, macro)
 }
 
+// UpdateManyOrganizations mocks base method.
+func (m *Client) UpdateManyOrganizations(ctx context.Context, orgIDs []int64, org zendesk.OrganizationUpdate) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManyOrganizations", ctx, orgIDs, org)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateManyOrganizations indicates an expected call of UpdateManyOrganizations.
+func (mr *ClientMockRecorder) UpdateManyOrganizations(ctx, orgIDs, org any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManyOrganizations", reflect.TypeOf((*Client)(nil).UpdateManyOrganizations), ctx, orgIDs, org)
+}
+
+// UpdateManyUsers mocks base method.
+func (m *Client) UpdateManyUsers(ctx context.Context, userIDs []int64, user zendesk.UserUpdate) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManyUsers", ctx, userIDs, user)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateManyUsers indicates an expected call of UpdateManyUsers.
+func (mr *ClientMockRecorder) UpdateManyUsers(ctx, userIDs, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManyUsers", reflect.TypeOf((*Client)(nil).UpdateManyUsers), ctx, userIDs, user)
+}
+
+// UpdateManyViews mocks base method.
+func (m *Client) UpdateManyViews(ctx context.Context, updates []zendesk.ViewBatchUpdate) ([]zendesk.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManyViews", ctx, updates)
+	ret0, _ := ret[0].([]zendesk.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateManyViews indicates an expected call of UpdateManyViews.
+func (mr *ClientMockRecorder) UpdateManyViews(ctx, updates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManyViews", reflect.TypeOf((*Client)(nil).UpdateManyViews), ctx, updates)
+}
+
 // UpdateOrganization mocks base method.
 func (m *Client) UpdateOrganization(ctx context.Context, orgID int64, org zendesk.Organization) (zendesk.Organization, error) {
 	m.ctrl.T.Helper()
@@ -2699,6 +5311,21 @@ func (mr *ClientMockRecorder) UpdateOrganization(ctx, orgID, org any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganization", reflect.TypeOf((*Client)(nil).UpdateOrganization), ctx, orgID, org)
 }
 
+// UpdateRequest mocks base method.
+func (m *Client) UpdateRequest(ctx context.Context, id int64, request zendesk.Request) (zendesk.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRequest", ctx, id, request)
+	ret0, _ := ret[0].(zendesk.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRequest indicates an expected call of UpdateRequest.
+func (mr *ClientMockRecorder) UpdateRequest(ctx, id, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRequest", reflect.TypeOf((*Client)(nil).UpdateRequest), ctx, id, request)
+}
+
 // UpdateSLAPolicy mocks base method.
 func (m *Client) UpdateSLAPolicy(ctx context.Context, id int64, slaPolicy zendesk.SLAPolicy) (zendesk.SLAPolicy, error) {
 	m.ctrl.T.Helper()
@@ -2714,6 +5341,66 @@ func (mr *ClientMockRecorder) UpdateSLAPolicy(ctx, id, slaPolicy any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSLAPolicy", reflect.TypeOf((*Client)(nil).UpdateSLAPolicy), ctx, id, slaPolicy)
 }
 
+// UpdateSchedule mocks base method.
+func (m *Client) UpdateSchedule(ctx context.Context, id int64, schedule zendesk.Schedule) (zendesk.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedule", ctx, id, schedule)
+	ret0, _ := ret[0].(zendesk.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *ClientMockRecorder) UpdateSchedule(ctx, id, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*Client)(nil).UpdateSchedule), ctx, id, schedule)
+}
+
+// UpdateScheduleHoliday mocks base method.
+func (m *Client) UpdateScheduleHoliday(ctx context.Context, scheduleID, holidayID int64, holiday zendesk.ScheduleHoliday) (zendesk.ScheduleHoliday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduleHoliday", ctx, scheduleID, holidayID, holiday)
+	ret0, _ := ret[0].(zendesk.ScheduleHoliday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduleHoliday indicates an expected call of UpdateScheduleHoliday.
+func (mr *ClientMockRecorder) UpdateScheduleHoliday(ctx, scheduleID, holidayID, holiday any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduleHoliday", reflect.TypeOf((*Client)(nil).UpdateScheduleHoliday), ctx, scheduleID, holidayID, holiday)
+}
+
+// UpdateScheduleIntervals mocks base method.
+func (m *Client) UpdateScheduleIntervals(ctx context.Context, id int64, intervals []zendesk.ScheduleInterval) ([]zendesk.ScheduleInterval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduleIntervals", ctx, id, intervals)
+	ret0, _ := ret[0].([]zendesk.ScheduleInterval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduleIntervals indicates an expected call of UpdateScheduleIntervals.
+func (mr *ClientMockRecorder) UpdateScheduleIntervals(ctx, id, intervals any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduleIntervals", reflect.TypeOf((*Client)(nil).UpdateScheduleIntervals), ctx, id, intervals)
+}
+
+// UpdateSideConversation mocks base method.
+func (m *Client) UpdateSideConversation(ctx context.Context, ticketID int64, id string, sideConversation zendesk.SideConversation) (zendesk.SideConversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSideConversation", ctx, ticketID, id, sideConversation)
+	ret0, _ := ret[0].(zendesk.SideConversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSideConversation indicates an expected call of UpdateSideConversation.
+func (mr *ClientMockRecorder) UpdateSideConversation(ctx, ticketID, id, sideConversation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSideConversation", reflect.TypeOf((*Client)(nil).UpdateSideConversation), ctx, ticketID, id, sideConversation)
+}
+
 // UpdateTarget mocks base method.
 func (m *Client) UpdateTarget(ctx context.Context, ticketID int64, field zendesk.Target) (zendesk.Target, error) {
 	m.ctrl.T.Helper()
@@ -2744,6 +5431,26 @@ func (mr *ClientMockRecorder) UpdateTicket(ctx, ticketID, ticket any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicket", reflect.TypeOf((*Client)(nil).UpdateTicket), ctx, ticketID, ticket)
 }
 
+// UpdateTicketEmailCCs mocks base method.
+func (m *Client) UpdateTicketEmailCCs(ctx context.Context, ticketID int64, updates ...zendesk.TicketParticipantUpdate) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, ticketID}
+	for _, a := range updates {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTicketEmailCCs", varargs...)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTicketEmailCCs indicates an expected call of UpdateTicketEmailCCs.
+func (mr *ClientMockRecorder) UpdateTicketEmailCCs(ctx, ticketID any, updates ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, ticketID}, updates...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicketEmailCCs", reflect.TypeOf((*Client)(nil).UpdateTicketEmailCCs), varargs...)
+}
+
 // UpdateTicketField mocks base method.
 func (m *Client) UpdateTicketField(ctx context.Context, ticketID int64, field zendesk.TicketField) (zendesk.TicketField, error) {
 	m.ctrl.T.Helper()
@@ -2759,6 +5466,26 @@ func (mr *ClientMockRecorder) UpdateTicketField(ctx, ticketID, field any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicketField", reflect.TypeOf((*Client)(nil).UpdateTicketField), ctx, ticketID, field)
 }
 
+// UpdateTicketFollowers mocks base method.
+func (m *Client) UpdateTicketFollowers(ctx context.Context, ticketID int64, updates ...zendesk.TicketParticipantUpdate) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, ticketID}
+	for _, a := range updates {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTicketFollowers", varargs...)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTicketFollowers indicates an expected call of UpdateTicketFollowers.
+func (mr *ClientMockRecorder) UpdateTicketFollowers(ctx, ticketID any, updates ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, ticketID}, updates...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicketFollowers", reflect.TypeOf((*Client)(nil).UpdateTicketFollowers), varargs...)
+}
+
 // UpdateTicketForm mocks base method.
 func (m *Client) UpdateTicketForm(ctx context.Context, id int64, form zendesk.TicketForm) (zendesk.TicketForm, error) {
 	m.ctrl.T.Helper()
@@ -2804,6 +5531,36 @@ func (mr *ClientMockRecorder) UpdateUser(ctx, userID, user any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*Client)(nil).UpdateUser), ctx, userID, user)
 }
 
+// UpdateUserIdentity mocks base method.
+func (m *Client) UpdateUserIdentity(ctx context.Context, userID, identityID int64, identity zendesk.UserIdentity) (zendesk.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserIdentity", ctx, userID, identityID, identity)
+	ret0, _ := ret[0].(zendesk.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserIdentity indicates an expected call of UpdateUserIdentity.
+func (mr *ClientMockRecorder) UpdateUserIdentity(ctx, userID, identityID, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserIdentity", reflect.TypeOf((*Client)(nil).UpdateUserIdentity), ctx, userID, identityID, identity)
+}
+
+// UpdateView mocks base method.
+func (m *Client) UpdateView(ctx context.Context, viewID int64, view zendesk.ViewUpdate) (zendesk.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateView", ctx, viewID, view)
+	ret0, _ := ret[0].(zendesk.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateView indicates an expected call of UpdateView.
+func (mr *ClientMockRecorder) UpdateView(ctx, viewID, view any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateView", reflect.TypeOf((*Client)(nil).UpdateView), ctx, viewID, view)
+}
+
 // UpdateWebhook mocks base method.
 func (m *Client) UpdateWebhook(ctx context.Context, webhookID string, hook *zendesk.Webhook) error {
 	m.ctrl.T.Helper()
@@ -2831,3 +5588,47 @@ func (mr *ClientMockRecorder) UploadAttachment(ctx, filename, token any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*Client)(nil).UploadAttachment), ctx, filename, token)
 }
+
+// UploadSideConversationAttachment mocks base method.
+func (m *Client) UploadSideConversationAttachment(ctx context.Context, filename string, r io.Reader) (zendesk.SideConversationAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadSideConversationAttachment", ctx, filename, r)
+	ret0, _ := ret[0].(zendesk.SideConversationAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadSideConversationAttachment indicates an expected call of UploadSideConversationAttachment.
+func (mr *ClientMockRecorder) UploadSideConversationAttachment(ctx, filename, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadSideConversationAttachment", reflect.TypeOf((*Client)(nil).UploadSideConversationAttachment), ctx, filename, r)
+}
+
+// ValidateSLAPolicy mocks base method.
+func (m *Client) ValidateSLAPolicy(ctx context.Context, slaPolicy zendesk.SLAPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSLAPolicy", ctx, slaPolicy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateSLAPolicy indicates an expected call of ValidateSLAPolicy.
+func (mr *ClientMockRecorder) ValidateSLAPolicy(ctx, slaPolicy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSLAPolicy", reflect.TypeOf((*Client)(nil).ValidateSLAPolicy), ctx, slaPolicy)
+}
+
+// VerifyUserIdentity mocks base method.
+func (m *Client) VerifyUserIdentity(ctx context.Context, userID, identityID int64) (zendesk.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyUserIdentity", ctx, userID, identityID)
+	ret0, _ := ret[0].(zendesk.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyUserIdentity indicates an expected call of VerifyUserIdentity.
+func (mr *ClientMockRecorder) VerifyUserIdentity(ctx, userID, identityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyUserIdentity", reflect.TypeOf((*Client)(nil).VerifyUserIdentity), ctx, userID, identityID)
+}
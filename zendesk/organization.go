@@ -26,6 +26,56 @@ type Organization struct {
 	OrganizationFields map[string]interface{} `json:"organization_fields,omitempty"`
 }
 
+// OrganizationUpdate is a partial organization used by create-or-update and bulk update requests.
+// Only the fields which are set are sent, so the other fields of the organizations are left unchanged.
+// ID or ExternalID identifies the organization in CreateOrUpdateOrganization and BatchUpdateManyOrganizations.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#update-many-organizations
+type OrganizationUpdate struct {
+	ID                 int64                  `json:"id,omitempty"`
+	ExternalID         string                 `json:"external_id,omitempty"`
+	Name               *string                `json:"name,omitempty"`
+	Details            *string                `json:"details,omitempty"`
+	Notes              *string                `json:"notes,omitempty"`
+	DomainNames        *[]string              `json:"domain_names,omitempty"`
+	GroupID            *int64                 `json:"group_id,omitempty"`
+	SharedTickets      *bool                  `json:"shared_tickets,omitempty"`
+	SharedComments     *bool                  `json:"shared_comments,omitempty"`
+	Tags               *[]string              `json:"tags,omitempty"`
+	OrganizationFields map[string]interface{} `json:"organization_fields,omitempty"`
+}
+
+// OrganizationBatchLimit is the maximum number of organizations accepted by the organization bulk endpoints at once
+const OrganizationBatchLimit = 100
+
+// OrganizationRelated contains organization related data
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-organizations-related-information
+type OrganizationRelated struct {
+	TicketsCount int64 `json:"tickets_count"`
+	UsersCount   int64 `json:"users_count"`
+}
+
+// OrganizationMerge is struct for organization merge payload.
+// Merges are processed in the background and Status can be polled with GetOrganizationMerge.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#merge-organization-with-another-organization
+type OrganizationMerge struct {
+	ID       string `json:"id,omitempty"`
+	URL      string `json:"url,omitempty"`
+	LoserID  int64  `json:"loser_id,omitempty"`
+	WinnerID int64  `json:"winner_id,omitempty"`
+	Status   string `json:"status,omitempty"`
+}
+
+// GetManyOrganizationsOptions is options for GetManyOrganizations
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-many-organizations
+type GetManyOrganizationsOptions struct {
+	ExternalIDs string `json:"external_ids,omitempty" url:"external_ids,omitempty"`
+	IDs         string `json:"ids,omitempty" url:"ids,omitempty"`
+}
+
 // OrganizationListOptions is options for GetOrganizations
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/organizations#list-organizations
@@ -44,6 +94,17 @@ type OrganizationAPI interface {
 	GetOrganizationsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Organization]
 	GetOrganizationsOBP(ctx context.Context, opts *OBPOptions) ([]Organization, Page, error)
 	GetOrganizationsCBP(ctx context.Context, opts *CBPOptions) ([]Organization, CursorPaginationMeta, error)
+	GetManyOrganizations(ctx context.Context, opts *GetManyOrganizationsOptions) ([]Organization, error)
+	AutocompleteOrganizations(ctx context.Context, name string) ([]Organization, error)
+	SearchOrganizationsByName(ctx context.Context, name string) ([]Organization, error)
+	GetOrganizationRelated(ctx context.Context, orgID int64) (OrganizationRelated, error)
+	CreateOrUpdateOrganization(ctx context.Context, org OrganizationUpdate) (Organization, error)
+	CreateManyOrganizations(ctx context.Context, orgs []Organization) ([]JobStatus, error)
+	UpdateManyOrganizations(ctx context.Context, orgIDs []int64, org OrganizationUpdate) ([]JobStatus, error)
+	BatchUpdateManyOrganizations(ctx context.Context, orgs []OrganizationUpdate) ([]JobStatus, error)
+	DestroyManyOrganizations(ctx context.Context, orgIDs []int64) ([]JobStatus, error)
+	MergeOrganizations(ctx context.Context, loserID, winnerID int64) (OrganizationMerge, error)
+	GetOrganizationMerge(ctx context.Context, mergeID string) (OrganizationMerge, error)
 }
 
 // GetOrganizations fetch organization list
@@ -172,3 +233,201 @@ func (z *Client) DeleteOrganization(ctx context.Context, orgID int64) error {
 
 	return nil
 }
+
+// GetManyOrganizations gets multiple organizations by IDs or external IDs
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-many-organizations
+func (z *Client) GetManyOrganizations(ctx context.Context, opts *GetManyOrganizationsOptions) ([]Organization, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = new(GetManyOrganizationsOptions)
+	}
+
+	u, err := addOptions("/organizations/show_many.json", tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	return z.getOrganizations(ctx, u)
+}
+
+// AutocompleteOrganizations returns organizations whose name starts with the given name
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#autocomplete-organizations
+func (z *Client) AutocompleteOrganizations(ctx context.Context, name string) ([]Organization, error) {
+	u, err := addOptions("/organizations/autocomplete.json", struct {
+		Name string `url:"name"`
+	}{name})
+	if err != nil {
+		return nil, err
+	}
+
+	return z.getOrganizations(ctx, u)
+}
+
+// SearchOrganizationsByName returns the organization whose name exactly matches the given name
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#search-organizations
+func (z *Client) SearchOrganizationsByName(ctx context.Context, name string) ([]Organization, error) {
+	u, err := addOptions("/organizations/search.json", struct {
+		Name string `url:"name"`
+	}{name})
+	if err != nil {
+		return nil, err
+	}
+
+	return z.getOrganizations(ctx, u)
+}
+
+func (z *Client) getOrganizations(ctx context.Context, path string) ([]Organization, error) {
+	var result struct {
+		Organizations []Organization `json:"organizations"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Organizations, nil
+}
+
+// GetOrganizationRelated retrieves organization related information
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-organizations-related-information
+func (z *Client) GetOrganizationRelated(ctx context.Context, orgID int64) (OrganizationRelated, error) {
+	var result struct {
+		OrganizationRelated OrganizationRelated `json:"organization_related"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/organizations/%d/related.json", orgID))
+	if err != nil {
+		return OrganizationRelated{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return OrganizationRelated{}, err
+	}
+
+	return result.OrganizationRelated, nil
+}
+
+// CreateOrUpdateOrganization creates new organization or updates a matching organization.
+// Organizations are matched by ID or ExternalID.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#create-or-update-organization
+func (z *Client) CreateOrUpdateOrganization(ctx context.Context, org OrganizationUpdate) (Organization, error) {
+	var data struct {
+		Organization OrganizationUpdate `json:"organization"`
+	}
+	var result struct {
+		Organization Organization `json:"organization"`
+	}
+
+	data.Organization = org
+
+	body, err := z.post(ctx, "/organizations/create_or_update.json", data)
+	if err != nil {
+		return Organization{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Organization{}, err
+	}
+
+	return result.Organization, nil
+}
+
+// CreateManyOrganizations creates organizations in the background.
+// Organizations are sent in chunks of OrganizationBatchLimit and a job status is returned for each chunk.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#create-many-organizations
+func (z *Client) CreateManyOrganizations(ctx context.Context, orgs []Organization) ([]JobStatus, error) {
+	return chunkJobs(orgs, OrganizationBatchLimit, func(orgs []Organization) (JobStatus, error) {
+		var data struct {
+			Organizations []Organization `json:"organizations"`
+		}
+		data.Organizations = orgs
+		return z.postJobStatus(ctx, "/organizations/create_many.json", data)
+	})
+}
+
+// UpdateManyOrganizations applies the same change to organizations in the background.
+// IDs are sent in chunks of OrganizationBatchLimit and a job status is returned for each chunk.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#update-many-organizations
+func (z *Client) UpdateManyOrganizations(ctx context.Context, orgIDs []int64, org OrganizationUpdate) ([]JobStatus, error) {
+	return chunkJobs(orgIDs, OrganizationBatchLimit, func(ids []int64) (JobStatus, error) {
+		var data struct {
+			Organization OrganizationUpdate `json:"organization"`
+		}
+		data.Organization = org
+		return z.putJobStatus(ctx, fmt.Sprintf("/organizations/update_many.json?ids=%s", joinIDs(ids)), data)
+	})
+}
+
+// BatchUpdateManyOrganizations applies a different change to each organization in the background.
+// Each organization must have ID set.
+// Organizations are sent in chunks of OrganizationBatchLimit and a job status is returned for each chunk.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#update-many-organizations
+func (z *Client) BatchUpdateManyOrganizations(ctx context.Context, orgs []OrganizationUpdate) ([]JobStatus, error) {
+	return chunkJobs(orgs, OrganizationBatchLimit, func(orgs []OrganizationUpdate) (JobStatus, error) {
+		var data struct {
+			Organizations []OrganizationUpdate `json:"organizations"`
+		}
+		data.Organizations = orgs
+		return z.putJobStatus(ctx, "/organizations/update_many.json", data)
+	})
+}
+
+// DestroyManyOrganizations deletes organizations in the background.
+// IDs are sent in chunks of OrganizationBatchLimit and a job status is returned for each chunk.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#bulk-delete-organizations
+func (z *Client) DestroyManyOrganizations(ctx context.Context, orgIDs []int64) ([]JobStatus, error) {
+	return chunkJobs(orgIDs, OrganizationBatchLimit, func(ids []int64) (JobStatus, error) {
+		return z.deleteJobStatus(ctx, fmt.Sprintf("/organizations/destroy_many.json?ids=%s", joinIDs(ids)))
+	})
+}
+
+// MergeOrganizations merges the loser organization into the winner organization.
+// Users, tickets and domain names of the loser are moved to the winner and the loser is deleted.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#merge-organization-with-another-organization
+func (z *Client) MergeOrganizations(ctx context.Context, loserID, winnerID int64) (OrganizationMerge, error) {
+	var data, result struct {
+		OrganizationMerge OrganizationMerge `json:"organization_merge"`
+	}
+
+	data.OrganizationMerge = OrganizationMerge{WinnerID: winnerID}
+
+	body, err := z.post(ctx, fmt.Sprintf("/organizations/%d/merge.json", loserID), data)
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+
+	return result.OrganizationMerge, nil
+}
+
+// GetOrganizationMerge gets the status of an organization merge
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-organization-merge
+func (z *Client) GetOrganizationMerge(ctx context.Context, mergeID string) (OrganizationMerge, error) {
+	var result struct {
+		OrganizationMerge OrganizationMerge `json:"organization_merge"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/organization_merges/%s.json", mergeID))
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+
+	return result.OrganizationMerge, nil
+}
@@ -7,6 +7,10 @@ import (
 	"time"
 )
 
+// OrganizationMembershipBatchLimit is the maximum number of memberships accepted by
+// the organization membership bulk endpoints at once
+const OrganizationMembershipBatchLimit = 100
+
 type (
 	// OrganizationMembership is struct for organization membership payload
 	// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/
@@ -44,6 +48,10 @@ type (
 		GetOrganizationMembershipsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[OrganizationMembership]
 		GetOrganizationMembershipsOBP(ctx context.Context, opts *OBPOptions) ([]OrganizationMembership, Page, error)
 		GetOrganizationMembershipsCBP(ctx context.Context, opts *CBPOptions) ([]OrganizationMembership, CursorPaginationMeta, error)
+		GetOrganizationMembership(context.Context, int64) (OrganizationMembership, error)
+		DeleteOrganizationMembership(context.Context, int64) error
+		CreateManyOrganizationMemberships(context.Context, []OrganizationMembershipOptions) ([]JobStatus, error)
+		DestroyManyOrganizationMemberships(context.Context, []int64) ([]JobStatus, error)
 	}
 )
 
@@ -121,3 +129,50 @@ func (z *Client) SetDefaultOrganization(ctx context.Context, opts OrganizationMe
 
 	return result.OrganizationMembership, nil
 }
+
+// GetOrganizationMembership gets a specified organization membership
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/#show-membership
+func (z *Client) GetOrganizationMembership(ctx context.Context, id int64) (OrganizationMembership, error) {
+	var result struct {
+		OrganizationMembership OrganizationMembership `json:"organization_membership"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/organization_memberships/%d.json", id))
+	if err != nil {
+		return OrganizationMembership{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return OrganizationMembership{}, err
+	}
+
+	return result.OrganizationMembership, nil
+}
+
+// DeleteOrganizationMembership deletes a specified organization membership
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/#delete-membership
+func (z *Client) DeleteOrganizationMembership(ctx context.Context, id int64) error {
+	return z.delete(ctx, fmt.Sprintf("/organization_memberships/%d.json", id))
+}
+
+// CreateManyOrganizationMemberships creates organization memberships in the background.
+// Memberships are sent in chunks of OrganizationMembershipBatchLimit and a job status is returned for each chunk.
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/#create-many-memberships
+func (z *Client) CreateManyOrganizationMemberships(ctx context.Context, opts []OrganizationMembershipOptions) ([]JobStatus, error) {
+	return chunkJobs(opts, OrganizationMembershipBatchLimit, func(opts []OrganizationMembershipOptions) (JobStatus, error) {
+		var data struct {
+			OrganizationMemberships []OrganizationMembershipOptions `json:"organization_memberships"`
+		}
+		data.OrganizationMemberships = opts
+		return z.postJobStatus(ctx, "/organization_memberships/create_many.json", data)
+	})
+}
+
+// DestroyManyOrganizationMemberships deletes organization memberships in the background.
+// IDs are sent in chunks of OrganizationMembershipBatchLimit and a job status is returned for each chunk.
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/#bulk-delete-memberships
+func (z *Client) DestroyManyOrganizationMemberships(ctx context.Context, ids []int64) ([]JobStatus, error) {
+	return chunkJobs(ids, OrganizationMembershipBatchLimit, func(ids []int64) (JobStatus, error) {
+		return z.deleteJobStatus(ctx, fmt.Sprintf("/organization_memberships/destroy_many.json?ids=%s", joinIDs(ids)))
+	})
+}
@@ -1,7 +1,11 @@
 package zendesk
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -48,3 +52,76 @@ func TestSetDefaultOrganization(t *testing.T) {
 		t.Fatalf("Returned org membership does not have the expected default status %v. It is %v", expectedDefault, orgMembership.Default)
 	}
 }
+
+func TestGetOrganizationMembership(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_membership.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.GetOrganizationMembership(ctx, 4)
+	if err != nil {
+		t.Fatalf("Failed to get organization membership: %s", err)
+	}
+}
+
+func TestDeleteOrganizationMembership(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/organization_memberships/4.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteOrganizationMembership(ctx, 4)
+	if err != nil {
+		t.Fatalf("Failed to delete organization membership: %s", err)
+	}
+}
+
+func TestCreateManyOrganizationMemberships(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			OrganizationMemberships []OrganizationMembershipOptions `json:"organization_memberships"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		if len(data.OrganizationMemberships) != 2 || data.OrganizationMemberships[1].UserID != 2 {
+			t.Fatalf("unexpected request body: %s", body)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobStatuses, err := client.CreateManyOrganizationMemberships(ctx, []OrganizationMembershipOptions{
+		{UserID: 1, OrganizationID: 10},
+		{UserID: 2, OrganizationID: 10},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create many organization memberships: %s", err)
+	}
+
+	if len(jobStatuses) != 1 {
+		t.Fatalf("expected length of job statuses is 1, but got %d", len(jobStatuses))
+	}
+}
+
+func TestDestroyManyOrganizationMemberships(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Query().Get("ids") != "4,5" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.DestroyManyOrganizationMemberships(ctx, []int64{4, 5})
+	if err != nil {
+		t.Fatalf("Failed to destroy many organization memberships: %s", err)
+	}
+}
@@ -0,0 +1,122 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type (
+	// OrganizationSubscription is struct for organization subscription payload.
+	// Subscribed users are notified of tickets of the organization.
+	// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_subscriptions/
+	OrganizationSubscription struct {
+		ID             int64      `json:"id,omitempty"`
+		UserID         int64      `json:"user_id"`
+		OrganizationID int64      `json:"organization_id"`
+		CreatedAt      *time.Time `json:"created_at,omitempty"`
+	}
+
+	// OrganizationSubscriptionListOptions is a struct for options for organization subscription list.
+	// Subscriptions of the organization or the user are listed if either ID is set.
+	// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_subscriptions/#list-organization-subscriptions
+	OrganizationSubscriptionListOptions struct {
+		PageOptions
+		OrganizationID int64 `url:"-"`
+		UserID         int64 `url:"-"`
+	}
+
+	// OrganizationSubscriptionAPI is an interface containing organization subscription related methods
+	OrganizationSubscriptionAPI interface {
+		GetOrganizationSubscriptions(context.Context, *OrganizationSubscriptionListOptions) ([]OrganizationSubscription, Page, error)
+		GetOrganizationSubscription(context.Context, int64) (OrganizationSubscription, error)
+		CreateOrganizationSubscription(context.Context, OrganizationSubscription) (OrganizationSubscription, error)
+		DeleteOrganizationSubscription(context.Context, int64) error
+		GetOrganizationSubscriptionsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[OrganizationSubscription]
+		GetOrganizationSubscriptionsOBP(ctx context.Context, opts *OBPOptions) ([]OrganizationSubscription, Page, error)
+		GetOrganizationSubscriptionsCBP(ctx context.Context, opts *CBPOptions) ([]OrganizationSubscription, CursorPaginationMeta, error)
+	}
+)
+
+// GetOrganizationSubscriptions gets organization subscriptions
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_subscriptions/#list-organization-subscriptions
+func (z *Client) GetOrganizationSubscriptions(ctx context.Context, opts *OrganizationSubscriptionListOptions) ([]OrganizationSubscription, Page, error) {
+	var result struct {
+		OrganizationSubscriptions []OrganizationSubscription `json:"organization_subscriptions"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = new(OrganizationSubscriptionListOptions)
+	}
+
+	path := "/organization_subscriptions.json"
+	if tmp.OrganizationID != 0 {
+		path = fmt.Sprintf("/organizations/%d/subscriptions.json", tmp.OrganizationID)
+	} else if tmp.UserID != 0 {
+		path = fmt.Sprintf("/users/%d/organization_subscriptions.json", tmp.UserID)
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &result)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	return result.OrganizationSubscriptions, result.Page, nil
+}
+
+// GetOrganizationSubscription gets a specified organization subscription
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_subscriptions/#show-organization-subscription
+func (z *Client) GetOrganizationSubscription(ctx context.Context, id int64) (OrganizationSubscription, error) {
+	var result struct {
+		OrganizationSubscription OrganizationSubscription `json:"organization_subscription"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/organization_subscriptions/%d.json", id))
+	if err != nil {
+		return OrganizationSubscription{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return OrganizationSubscription{}, err
+	}
+
+	return result.OrganizationSubscription, nil
+}
+
+// CreateOrganizationSubscription subscribes a user to an organization
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_subscriptions/#create-organization-subscription
+func (z *Client) CreateOrganizationSubscription(ctx context.Context, subscription OrganizationSubscription) (OrganizationSubscription, error) {
+	var data, result struct {
+		OrganizationSubscription OrganizationSubscription `json:"organization_subscription"`
+	}
+
+	data.OrganizationSubscription = OrganizationSubscription{
+		UserID:         subscription.UserID,
+		OrganizationID: subscription.OrganizationID,
+	}
+
+	body, err := z.post(ctx, "/organization_subscriptions.json", data)
+	if err != nil {
+		return OrganizationSubscription{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return OrganizationSubscription{}, err
+	}
+
+	return result.OrganizationSubscription, nil
+}
+
+// DeleteOrganizationSubscription deletes a specified organization subscription
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_subscriptions/#delete-organization-subscription
+func (z *Client) DeleteOrganizationSubscription(ctx context.Context, id int64) error {
+	return z.delete(ctx, fmt.Sprintf("/organization_subscriptions/%d.json", id))
+}
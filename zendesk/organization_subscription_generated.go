@@ -0,0 +1,74 @@
+
+// Code generated by Script. DO NOT EDIT.
+// Source: script/codegen/main.go
+//
+// Generated by this command:
+//
+//	go run script/codegen/main.go
+
+package zendesk
+
+import "context"
+
+func (z *Client) GetOrganizationSubscriptionsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[OrganizationSubscription] {
+	return &Iterator[OrganizationSubscription]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         opts.IsCBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc:       z.GetOrganizationSubscriptionsOBP,
+		cbpFunc:       z.GetOrganizationSubscriptionsCBP,
+	}
+}
+
+func (z *Client) GetOrganizationSubscriptionsOBP(ctx context.Context, opts *OBPOptions) ([]OrganizationSubscription, Page, error) {
+	var data struct {
+		OrganizationSubscriptions []OrganizationSubscription `json:"organization_subscriptions"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OBPOptions{}
+	}
+	
+	u, err := addOptions("/organization_subscriptions.json", tmp)
+	
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.OrganizationSubscriptions, data.Page, nil
+}
+
+func (z *Client) GetOrganizationSubscriptionsCBP(ctx context.Context, opts *CBPOptions) ([]OrganizationSubscription, CursorPaginationMeta, error) {
+	var data struct {
+		OrganizationSubscriptions []OrganizationSubscription `json:"organization_subscriptions"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+	
+	u, err := addOptions("/organization_subscriptions.json", tmp)
+	
+	if err != nil {
+		return nil, data.Meta, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	return data.OrganizationSubscriptions, data.Meta, nil
+}
+
@@ -0,0 +1,82 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetOrganizationSubscriptions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_subscriptions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	subscriptions, _, err := client.GetOrganizationSubscriptions(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get organization subscriptions: %s", err)
+	}
+
+	if len(subscriptions) != 2 {
+		t.Fatalf("expected length of organization subscriptions is 2, but got %d", len(subscriptions))
+	}
+}
+
+func TestGetOrganizationSubscriptionsByOrganization(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/32/subscriptions.json" {
+			t.Fatalf("unexpected request path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "organization_subscriptions.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, _, err := client.GetOrganizationSubscriptions(ctx, &OrganizationSubscriptionListOptions{OrganizationID: 32})
+	if err != nil {
+		t.Fatalf("Failed to get organization subscriptions: %s", err)
+	}
+}
+
+func TestGetOrganizationSubscription(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_subscription.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	subscription, err := client.GetOrganizationSubscription(ctx, 1234)
+	if err != nil {
+		t.Fatalf("Failed to get organization subscription: %s", err)
+	}
+
+	if subscription.ID != 1234 {
+		t.Fatalf("expected id of organization subscription is 1234, but got %d", subscription.ID)
+	}
+}
+
+func TestCreateOrganizationSubscription(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "organization_subscription.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	subscription, err := client.CreateOrganizationSubscription(ctx, OrganizationSubscription{UserID: 482, OrganizationID: 32})
+	if err != nil {
+		t.Fatalf("Failed to create organization subscription: %s", err)
+	}
+
+	if subscription.UserID != 482 {
+		t.Fatalf("expected user id of organization subscription is 482, but got %d", subscription.UserID)
+	}
+}
+
+func TestDeleteOrganizationSubscription(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteOrganizationSubscription(ctx, 1234)
+	if err != nil {
+		t.Fatalf("Failed to delete organization subscription: %s", err)
+	}
+}
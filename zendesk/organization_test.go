@@ -1,8 +1,11 @@
 package zendesk
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -76,3 +79,214 @@ func TestDeleteOrganization(t *testing.T) {
 		t.Fatalf("Failed to delete organization: %s", err)
 	}
 }
+
+func TestGetManyOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/show_many.json" || r.URL.Query().Get("ids") != "1,2" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "organizations.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	orgs, err := client.GetManyOrganizations(ctx, &GetManyOrganizationsOptions{IDs: "1,2"})
+	if err != nil {
+		t.Fatalf("Failed to get many organizations: %s", err)
+	}
+
+	if len(orgs) != 2 {
+		t.Fatalf("expected length of organizations is 2, but got %d", len(orgs))
+	}
+}
+
+func TestAutocompleteOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/autocomplete.json" || r.URL.Query().Get("name") != "imp" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "organizations.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.AutocompleteOrganizations(ctx, "imp")
+	if err != nil {
+		t.Fatalf("Failed to autocomplete organizations: %s", err)
+	}
+}
+
+func TestSearchOrganizationsByName(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/search.json" || r.URL.Query().Get("name") != "Acme Inc" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "organizations.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.SearchOrganizationsByName(ctx, "Acme Inc")
+	if err != nil {
+		t.Fatalf("Failed to search organizations by name: %s", err)
+	}
+}
+
+func TestGetOrganizationRelated(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_related.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	related, err := client.GetOrganizationRelated(ctx, 123)
+	if err != nil {
+		t.Fatalf("Failed to get organization related information: %s", err)
+	}
+
+	if related.TicketsCount != 12 || related.UsersCount != 4 {
+		t.Fatalf("unexpected organization related information %v", related)
+	}
+}
+
+func TestCreateOrUpdateOrganization(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path != "/organizations/create_or_update.json" || string(body) != `{"organization":{"external_id":"acme","name":"Acme"}}` {
+			t.Fatalf("unexpected request %s: %s", r.URL.Path, body)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "organization.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	name := "Acme"
+	_, err := client.CreateOrUpdateOrganization(ctx, OrganizationUpdate{ExternalID: "acme", Name: &name})
+	if err != nil {
+		t.Fatalf("Failed to create or update organization: %s", err)
+	}
+}
+
+func TestCreateManyOrganizations(t *testing.T) {
+	var sizes []int
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Organizations []Organization `json:"organizations"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		sizes = append(sizes, len(data.Organizations))
+		w.Write(readFixture(filepath.Join(http.MethodPost, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobStatuses, err := client.CreateManyOrganizations(ctx, make([]Organization, OrganizationBatchLimit+5))
+	if err != nil {
+		t.Fatalf("Failed to create many organizations: %s", err)
+	}
+
+	if len(jobStatuses) != 2 || sizes[1] != 5 {
+		t.Fatalf("unexpected chunks %v", sizes)
+	}
+}
+
+func TestUpdateManyOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Query().Get("ids") != "1,2" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+
+		var data struct {
+			Organization map[string]interface{} `json:"organization"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		if len(data.Organization) != 2 || data.Organization["notes"] != "VIP" || data.Organization["shared_tickets"] != false {
+			t.Fatalf("expected only notes and shared_tickets to be sent, but got %s", body)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	notes, shared := "VIP", false
+	_, err := client.UpdateManyOrganizations(ctx, []int64{1, 2}, OrganizationUpdate{Notes: &notes, SharedTickets: &shared})
+	if err != nil {
+		t.Fatalf("Failed to update many organizations: %s", err)
+	}
+}
+
+func TestBatchUpdateManyOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"organizations":[{"id":1,"notes":"A"},{"id":2,"tags":["vip"]}]}` {
+			t.Fatalf("unexpected request body %s", body)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	notes, tags := "A", []string{"vip"}
+	_, err := client.BatchUpdateManyOrganizations(ctx, []OrganizationUpdate{{ID: 1, Notes: &notes}, {ID: 2, Tags: &tags}})
+	if err != nil {
+		t.Fatalf("Failed to batch update many organizations: %s", err)
+	}
+}
+
+func TestDestroyManyOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Query().Get("ids") != "1,2" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.DestroyManyOrganizations(ctx, []int64{1, 2})
+	if err != nil {
+		t.Fatalf("Failed to destroy many organizations: %s", err)
+	}
+}
+
+func TestMergeOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path != "/organizations/123/merge.json" || string(body) != `{"organization_merge":{"winner_id":456}}` {
+			t.Fatalf("unexpected request %s: %s", r.URL.Path, body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "organization_merge.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	merge, err := client.MergeOrganizations(ctx, 123, 456)
+	if err != nil {
+		t.Fatalf("Failed to merge organizations: %s", err)
+	}
+
+	if merge.ID == "" || merge.Status != "new" {
+		t.Fatalf("unexpected organization merge %v", merge)
+	}
+}
+
+func TestGetOrganizationMerge(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_merge.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	merge, err := client.GetOrganizationMerge(ctx, "01HPZM6206BF4G63783E5349AD")
+	if err != nil {
+		t.Fatalf("Failed to get organization merge: %s", err)
+	}
+
+	if merge.Status != "complete" {
+		t.Fatalf("expected status of organization merge is complete, but got %s", merge.Status)
+	}
+}
@@ -0,0 +1,256 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Request is struct for end-user request payload.
+// Requests are tickets seen from the end user's perspective.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-requests/
+type Request struct {
+	ID               int64         `json:"id,omitempty"`
+	URL              string        `json:"url,omitempty"`
+	Subject          string        `json:"subject,omitempty"`
+	Description      string        `json:"description,omitempty"`
+	Status           string        `json:"status,omitempty"`
+	CustomStatusID   int64         `json:"custom_status_id,omitempty"`
+	Priority         string        `json:"priority,omitempty"`
+	Type             string        `json:"type,omitempty"`
+	Recipient        string        `json:"recipient,omitempty"`
+	RequesterID      int64         `json:"requester_id,omitempty"`
+	AssigneeID       int64         `json:"assignee_id,omitempty"`
+	GroupID          int64         `json:"group_id,omitempty"`
+	OrganizationID   int64         `json:"organization_id,omitempty"`
+	CollaboratorIDs  []int64       `json:"collaborator_ids,omitempty"`
+	EmailCCIDs       []int64       `json:"email_cc_ids,omitempty"`
+	FollowupSourceID int64         `json:"followup_source_id,omitempty"`
+	TicketFormID     int64         `json:"ticket_form_id,omitempty"`
+	BrandID          int64         `json:"brand_id,omitempty"`
+	IsPublic         bool          `json:"is_public,omitempty"`
+	CanBeSolvedByMe  bool          `json:"can_be_solved_by_me,omitempty"`
+	Solved           bool          `json:"solved,omitempty"`
+	DueAt            *time.Time    `json:"due_at,omitempty"`
+	CustomFields     []CustomField `json:"custom_fields,omitempty"`
+	Via              *Via          `json:"via,omitempty"`
+	CreatedAt        *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time    `json:"updated_at,omitempty"`
+
+	// Comment is POST/PUT only and required on creation.
+	// Attach files by setting upload tokens from UploadAttachment to Comment.Uploads.
+	Comment *TicketComment `json:"comment,omitempty"`
+
+	// Requester is POST only and required for anonymous requests
+	Requester *Requester `json:"requester,omitempty"`
+
+	// Collaborators is POST only
+	Collaborators *Collaborators `json:"collaborators,omitempty"`
+
+	// AdditionalCollaborators is PUT only and adds CCs to the request
+	AdditionalCollaborators *Collaborators `json:"additional_collaborators,omitempty"`
+}
+
+// RequestListOptions is options for GetRequests
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-requests/#list-requests
+type RequestListOptions struct {
+	PageOptions
+
+	// Status is a comma separated list of statuses, e.g. "hold,open"
+	Status string `url:"status,omitempty"`
+
+	// SortBy can take "updated_at", "created_at"
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// SearchRequestsOptions is options for SearchRequests
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-requests/#search-requests
+type SearchRequestsOptions struct {
+	PageOptions
+	Query          string `url:"query,omitempty"`
+	Status         string `url:"status,omitempty"`
+	OrganizationID int64  `url:"organization_id,omitempty"`
+	CCID           bool   `url:"cc_id,omitempty"`
+}
+
+// RequestAPI an interface containing all end-user request related methods
+type RequestAPI interface {
+	GetRequests(ctx context.Context, opts *RequestListOptions) ([]Request, Page, error)
+	SearchRequests(ctx context.Context, opts *SearchRequestsOptions) ([]Request, Page, error)
+	GetRequest(ctx context.Context, id int64) (Request, error)
+	CreateRequest(ctx context.Context, request Request) (Request, error)
+	UpdateRequest(ctx context.Context, id int64, request Request) (Request, error)
+	GetRequestComments(ctx context.Context, requestID int64, opts *PageOptions) ([]TicketComment, Page, error)
+	GetRequestComment(ctx context.Context, requestID, commentID int64) (TicketComment, error)
+	GetRequestsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Request]
+	GetRequestsOBP(ctx context.Context, opts *OBPOptions) ([]Request, Page, error)
+	GetRequestsCBP(ctx context.Context, opts *CBPOptions) ([]Request, CursorPaginationMeta, error)
+}
+
+// GetRequests fetch request list of the authenticated user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-requests/#list-requests
+func (z *Client) GetRequests(ctx context.Context, opts *RequestListOptions) ([]Request, Page, error) {
+	var data struct {
+		Requests []Request `json:"requests"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &RequestListOptions{}
+	}
+
+	u, err := addOptions("/requests.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Requests, data.Page, nil
+}
+
+// SearchRequests searches requests of the authenticated user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-requests/#search-requests
+func (z *Client) SearchRequests(ctx context.Context, opts *SearchRequestsOptions) ([]Request, Page, error) {
+	var data struct {
+		Requests []Request `json:"requests"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &SearchRequestsOptions{}
+	}
+
+	u, err := addOptions("/requests/search.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Requests, data.Page, nil
+}
+
+// GetRequest gets a specified request
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-requests/#show-request
+func (z *Client) GetRequest(ctx context.Context, id int64) (Request, error) {
+	var result struct {
+		Request Request `json:"request"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/requests/%d.json", id))
+	if err != nil {
+		return Request{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Request{}, err
+	}
+	return result.Request, nil
+}
+
+// CreateRequest creates a new request.
+// Anonymous requests can be created by setting Requester and not setting credential to the client.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-requests/#create-request
+func (z *Client) CreateRequest(ctx context.Context, request Request) (Request, error) {
+	var data, result struct {
+		Request Request `json:"request"`
+	}
+	data.Request = request
+
+	body, err := z.post(ctx, "/requests.json", data)
+	if err != nil {
+		return Request{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Request{}, err
+	}
+	return result.Request, nil
+}
+
+// UpdateRequest updates a request, typically by adding a comment or marking it solved
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-requests/#update-request
+func (z *Client) UpdateRequest(ctx context.Context, id int64, request Request) (Request, error) {
+	var data, result struct {
+		Request Request `json:"request"`
+	}
+	data.Request = request
+
+	body, err := z.put(ctx, fmt.Sprintf("/requests/%d.json", id), data)
+	if err != nil {
+		return Request{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Request{}, err
+	}
+	return result.Request, nil
+}
+
+// GetRequestComments fetch public comments of a request
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-requests/#listing-comments
+func (z *Client) GetRequestComments(ctx context.Context, requestID int64, opts *PageOptions) ([]TicketComment, Page, error) {
+	var data struct {
+		Comments []TicketComment `json:"comments"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/requests/%d/comments.json", requestID), tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Comments, data.Page, nil
+}
+
+// GetRequestComment gets a specified comment of a request
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-requests/#getting-comments
+func (z *Client) GetRequestComment(ctx context.Context, requestID, commentID int64) (TicketComment, error) {
+	var result struct {
+		Comment TicketComment `json:"comment"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/requests/%d/comments/%d.json", requestID, commentID))
+	if err != nil {
+		return TicketComment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TicketComment{}, err
+	}
+	return result.Comment, nil
+}
@@ -0,0 +1,74 @@
+
+// Code generated by Script. DO NOT EDIT.
+// Source: script/codegen/main.go
+//
+// Generated by this command:
+//
+//	go run script/codegen/main.go
+
+package zendesk
+
+import "context"
+
+func (z *Client) GetRequestsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Request] {
+	return &Iterator[Request]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         opts.IsCBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc:       z.GetRequestsOBP,
+		cbpFunc:       z.GetRequestsCBP,
+	}
+}
+
+func (z *Client) GetRequestsOBP(ctx context.Context, opts *OBPOptions) ([]Request, Page, error) {
+	var data struct {
+		Requests []Request `json:"requests"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OBPOptions{}
+	}
+	
+	u, err := addOptions("/requests.json", tmp)
+	
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Requests, data.Page, nil
+}
+
+func (z *Client) GetRequestsCBP(ctx context.Context, opts *CBPOptions) ([]Request, CursorPaginationMeta, error) {
+	var data struct {
+		Requests []Request `json:"requests"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+	
+	u, err := addOptions("/requests.json", tmp)
+	
+	if err != nil {
+		return nil, data.Meta, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	return data.Requests, data.Meta, nil
+}
+
@@ -0,0 +1,173 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetRequests(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status := r.URL.Query().Get("status"); status != "open,pending" {
+			t.Fatalf("unexpected status query: %s", status)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "requests.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	requests, _, err := client.GetRequests(ctx, &RequestListOptions{Status: "open,pending"})
+	if err != nil {
+		t.Fatalf("Failed to get requests: %s", err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected length of requests is %d, but got %d", 2, len(requests))
+	}
+}
+
+func TestGetRequestsIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "requests.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ops := NewPaginationOptions()
+	ops.Status = "open"
+	it := client.GetRequestsIterator(ctx, ops)
+
+	count := 0
+	for it.HasMore() {
+		requests, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get requests: %s", err)
+		}
+		count += len(requests)
+	}
+	if count != 2 {
+		t.Fatalf("expected length of requests is %d, but got %d", 2, count)
+	}
+}
+
+func TestSearchRequests(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "requests.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	requests, _, err := client.SearchRequests(ctx, &SearchRequestsOptions{Query: "printer"})
+	if err != nil {
+		t.Fatalf("Failed to search requests: %s", err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected length of requests is %d, but got %d", 2, len(requests))
+	}
+}
+
+func TestGetRequest(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "request.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	request, err := client.GetRequest(ctx, 35436)
+	if err != nil {
+		t.Fatalf("Failed to get request: %s", err)
+	}
+
+	expectedID := int64(35436)
+	if request.ID != expectedID {
+		t.Fatalf("Returned request does not have the expected ID %d. Request id is %d", expectedID, request.ID)
+	}
+}
+
+func TestCreateAnonymousRequest(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Request Request `json:"request"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		if data.Request.Requester == nil || data.Request.Requester.Email != "anonymous@example.com" {
+			t.Fatalf("expected requester in request body: %s", body)
+		}
+		if data.Request.Comment == nil || len(data.Request.Comment.Uploads) != 1 {
+			t.Fatalf("expected comment with upload token in request body: %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "request.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	request, err := client.CreateRequest(ctx, Request{
+		Subject: "Help!",
+		Comment: &TicketComment{
+			Body:    "My printer is on fire!",
+			Uploads: []string{"vz7ll9ud8oofowy"},
+		},
+		Requester: &Requester{
+			Name:  "Anonymous customer",
+			Email: "anonymous@example.com",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create request: %s", err)
+	}
+
+	if request.ID != 35436 {
+		t.Fatalf("expected request id %d, but got %d", 35436, request.ID)
+	}
+}
+
+func TestUpdateRequest(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "request.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	request, err := client.UpdateRequest(ctx, 35436, Request{
+		Comment: &TicketComment{Body: "Thanks!"},
+		Solved:  true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update request: %s", err)
+	}
+
+	if request.Status != "solved" {
+		t.Fatalf("expected status %s, but got %s", "solved", request.Status)
+	}
+}
+
+func TestGetRequestComments(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "request_comments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	comments, _, err := client.GetRequestComments(ctx, 35436, nil)
+	if err != nil {
+		t.Fatalf("Failed to get request comments: %s", err)
+	}
+
+	if len(comments) != 1 {
+		t.Fatalf("expected length of comments is %d, but got %d", 1, len(comments))
+	}
+}
+
+func TestGetRequestComment(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "request_comment.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	comment, err := client.GetRequestComment(ctx, 35436, 43)
+	if err != nil {
+		t.Fatalf("Failed to get request comment: %s", err)
+	}
+
+	if comment.ID != 43 {
+		t.Fatalf("expected comment id %d, but got %d", 43, comment.ID)
+	}
+}
@@ -0,0 +1,192 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Satisfaction rating scores
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_ratings/#json-format
+const (
+	SatisfactionScoreOffered                = "offered"
+	SatisfactionScoreUnoffered              = "unoffered"
+	SatisfactionScoreGood                   = "good"
+	SatisfactionScoreBad                    = "bad"
+	SatisfactionScoreReceived               = "received"
+	SatisfactionScoreReceivedWithComment    = "received_with_comment"
+	SatisfactionScoreReceivedWithoutComment = "received_without_comment"
+	SatisfactionScoreGoodWithComment        = "good_with_comment"
+	SatisfactionScoreGoodWithoutComment     = "good_without_comment"
+	SatisfactionScoreBadWithComment         = "bad_with_comment"
+	SatisfactionScoreBadWithoutComment      = "bad_without_comment"
+)
+
+// SatisfactionRating is struct for satisfaction rating payload
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_ratings/
+type SatisfactionRating struct {
+	ID          int64      `json:"id,omitempty"`
+	URL         string     `json:"url,omitempty"`
+	AssigneeID  int64      `json:"assignee_id,omitempty"`
+	GroupID     int64      `json:"group_id,omitempty"`
+	RequesterID int64      `json:"requester_id,omitempty"`
+	TicketID    int64      `json:"ticket_id,omitempty"`
+	Score       string     `json:"score"`
+	Comment     string     `json:"comment,omitempty"`
+	Reason      string     `json:"reason,omitempty"`
+	ReasonID    int64      `json:"reason_id,omitempty"`
+	ReasonCode  int64      `json:"reason_code,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// SatisfactionReason is struct for satisfaction reason payload
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_reasons/
+type SatisfactionReason struct {
+	ID         int64      `json:"id,omitempty"`
+	URL        string     `json:"url,omitempty"`
+	ReasonCode int64      `json:"reason_code,omitempty"`
+	Value      string     `json:"value,omitempty"`
+	RawValue   string     `json:"raw_value,omitempty"`
+	Deleted    bool       `json:"deleted,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// SatisfactionRatingListOptions is options for GetSatisfactionRatings
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_ratings/#list-satisfaction-ratings
+type SatisfactionRatingListOptions struct {
+	PageOptions
+
+	// Score can take one of the SatisfactionScore* constants
+	Score string `url:"score,omitempty"`
+
+	// StartTime and EndTime are unix epoch seconds
+	StartTime int64 `url:"start_time,omitempty"`
+	EndTime   int64 `url:"end_time,omitempty"`
+}
+
+// SatisfactionRatingAPI an interface containing all satisfaction rating related methods
+type SatisfactionRatingAPI interface {
+	GetSatisfactionRatings(ctx context.Context, opts *SatisfactionRatingListOptions) ([]SatisfactionRating, Page, error)
+	GetSatisfactionRating(ctx context.Context, id int64) (SatisfactionRating, error)
+	CreateSatisfactionRating(ctx context.Context, ticketID int64, rating SatisfactionRating) (SatisfactionRating, error)
+	GetSatisfactionReasons(ctx context.Context) ([]SatisfactionReason, error)
+	GetSatisfactionReason(ctx context.Context, id int64) (SatisfactionReason, error)
+	GetSatisfactionRatingsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SatisfactionRating]
+	GetSatisfactionRatingsOBP(ctx context.Context, opts *OBPOptions) ([]SatisfactionRating, Page, error)
+	GetSatisfactionRatingsCBP(ctx context.Context, opts *CBPOptions) ([]SatisfactionRating, CursorPaginationMeta, error)
+}
+
+// GetSatisfactionRatings fetch satisfaction rating list
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_ratings/#list-satisfaction-ratings
+func (z *Client) GetSatisfactionRatings(ctx context.Context, opts *SatisfactionRatingListOptions) ([]SatisfactionRating, Page, error) {
+	var data struct {
+		SatisfactionRatings []SatisfactionRating `json:"satisfaction_ratings"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &SatisfactionRatingListOptions{}
+	}
+
+	u, err := addOptions("/satisfaction_ratings.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.SatisfactionRatings, data.Page, nil
+}
+
+// GetSatisfactionRating gets a specified satisfaction rating
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_ratings/#show-satisfaction-rating
+func (z *Client) GetSatisfactionRating(ctx context.Context, id int64) (SatisfactionRating, error) {
+	var result struct {
+		SatisfactionRating SatisfactionRating `json:"satisfaction_rating"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/satisfaction_ratings/%d.json", id))
+	if err != nil {
+		return SatisfactionRating{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SatisfactionRating{}, err
+	}
+	return result.SatisfactionRating, nil
+}
+
+// CreateSatisfactionRating creates a satisfaction rating on a solved ticket.
+// Only the requester of the ticket can rate it.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_ratings/#create-a-satisfaction-rating
+func (z *Client) CreateSatisfactionRating(ctx context.Context, ticketID int64, rating SatisfactionRating) (SatisfactionRating, error) {
+	var data, result struct {
+		SatisfactionRating SatisfactionRating `json:"satisfaction_rating"`
+	}
+	data.SatisfactionRating = rating
+
+	body, err := z.post(ctx, fmt.Sprintf("/tickets/%d/satisfaction_rating.json", ticketID), data)
+	if err != nil {
+		return SatisfactionRating{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SatisfactionRating{}, err
+	}
+	return result.SatisfactionRating, nil
+}
+
+// GetSatisfactionReasons fetch satisfaction reason list
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_reasons/#list-reasons-for-satisfaction-rating
+func (z *Client) GetSatisfactionReasons(ctx context.Context) ([]SatisfactionReason, error) {
+	var result struct {
+		Reasons []SatisfactionReason `json:"reasons"`
+	}
+
+	body, err := z.get(ctx, "/satisfaction_reasons.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Reasons, nil
+}
+
+// GetSatisfactionReason gets a specified satisfaction reason
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_reasons/#show-reason-for-satisfaction-rating
+func (z *Client) GetSatisfactionReason(ctx context.Context, id int64) (SatisfactionReason, error) {
+	var result struct {
+		Reason SatisfactionReason `json:"reason"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/satisfaction_reasons/%d.json", id))
+	if err != nil {
+		return SatisfactionReason{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SatisfactionReason{}, err
+	}
+	return result.Reason, nil
+}
@@ -0,0 +1,74 @@
+
+// Code generated by Script. DO NOT EDIT.
+// Source: script/codegen/main.go
+//
+// Generated by this command:
+//
+//	go run script/codegen/main.go
+
+package zendesk
+
+import "context"
+
+func (z *Client) GetSatisfactionRatingsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SatisfactionRating] {
+	return &Iterator[SatisfactionRating]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         opts.IsCBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc:       z.GetSatisfactionRatingsOBP,
+		cbpFunc:       z.GetSatisfactionRatingsCBP,
+	}
+}
+
+func (z *Client) GetSatisfactionRatingsOBP(ctx context.Context, opts *OBPOptions) ([]SatisfactionRating, Page, error) {
+	var data struct {
+		SatisfactionRatings []SatisfactionRating `json:"satisfaction_ratings"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OBPOptions{}
+	}
+	
+	u, err := addOptions("/satisfaction_ratings.json", tmp)
+	
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.SatisfactionRatings, data.Page, nil
+}
+
+func (z *Client) GetSatisfactionRatingsCBP(ctx context.Context, opts *CBPOptions) ([]SatisfactionRating, CursorPaginationMeta, error) {
+	var data struct {
+		SatisfactionRatings []SatisfactionRating `json:"satisfaction_ratings"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+	
+	u, err := addOptions("/satisfaction_ratings.json", tmp)
+	
+	if err != nil {
+		return nil, data.Meta, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	return data.SatisfactionRatings, data.Meta, nil
+}
+
@@ -0,0 +1,122 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetSatisfactionRatings(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("score") != SatisfactionScoreBad || q.Get("start_time") != "1498151194" || q.Get("end_time") != "1498161194" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "satisfaction_ratings.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ratings, _, err := client.GetSatisfactionRatings(ctx, &SatisfactionRatingListOptions{
+		Score:     SatisfactionScoreBad,
+		StartTime: 1498151194,
+		EndTime:   1498161194,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get satisfaction ratings: %s", err)
+	}
+
+	if len(ratings) != 2 {
+		t.Fatalf("expected length of satisfaction ratings is %d, but got %d", 2, len(ratings))
+	}
+	if ratings[1].Reason != "The issue was not resolved" {
+		t.Fatalf("unexpected reason: %s", ratings[1].Reason)
+	}
+}
+
+func TestGetSatisfactionRatingsIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "satisfaction_ratings.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ops := NewPaginationOptions()
+	ops.Score = SatisfactionScoreGood
+	it := client.GetSatisfactionRatingsIterator(ctx, ops)
+
+	count := 0
+	for it.HasMore() {
+		ratings, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get satisfaction ratings: %s", err)
+		}
+		count += len(ratings)
+	}
+	if count != 2 {
+		t.Fatalf("expected length of satisfaction ratings is %d, but got %d", 2, count)
+	}
+}
+
+func TestGetSatisfactionRating(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "satisfaction_rating.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	rating, err := client.GetSatisfactionRating(ctx, 35436)
+	if err != nil {
+		t.Fatalf("Failed to get satisfaction rating: %s", err)
+	}
+
+	expectedID := int64(35436)
+	if rating.ID != expectedID {
+		t.Fatalf("Returned satisfaction rating does not have the expected ID %d. Satisfaction rating id is %d", expectedID, rating.ID)
+	}
+}
+
+func TestCreateSatisfactionRating(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "satisfaction_rating.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	rating, err := client.CreateSatisfactionRating(ctx, 208, SatisfactionRating{
+		Score:   SatisfactionScoreGood,
+		Comment: "Awesome support!",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create satisfaction rating: %s", err)
+	}
+
+	if rating.TicketID != 208 {
+		t.Fatalf("expected ticket id %d, but got %d", 208, rating.TicketID)
+	}
+}
+
+func TestGetSatisfactionReasons(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "satisfaction_reasons.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	reasons, err := client.GetSatisfactionReasons(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get satisfaction reasons: %s", err)
+	}
+
+	if len(reasons) != 2 {
+		t.Fatalf("expected length of satisfaction reasons is %d, but got %d", 2, len(reasons))
+	}
+}
+
+func TestGetSatisfactionReason(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "satisfaction_reason.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	reason, err := client.GetSatisfactionReason(ctx, 35121)
+	if err != nil {
+		t.Fatalf("Failed to get satisfaction reason: %s", err)
+	}
+
+	if reason.ReasonCode != 1000 {
+		t.Fatalf("expected reason code %d, but got %d", 1000, reason.ReasonCode)
+	}
+}
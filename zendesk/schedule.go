@@ -0,0 +1,308 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MinutesPerWeek is the number of minutes in a week.
+// Intervals of a schedule are measured in minutes from Sunday 00:00.
+const MinutesPerWeek = 7 * 24 * 60
+
+// ScheduleHolidayDateFormat is the date format of StartDate and EndDate of ScheduleHoliday
+const ScheduleHolidayDateFormat = "2006-01-02"
+
+// MinuteOfWeek returns the number of minutes from Sunday 00:00 to the given time of the day
+func MinuteOfWeek(day time.Weekday, hour, minute int) int {
+	return int(day)*24*60 + hour*60 + minute
+}
+
+// ScheduleInterval is a period of business hours of a schedule.
+// StartTime and EndTime are in minutes from Sunday 00:00 in the time zone of the schedule.
+type ScheduleInterval struct {
+	StartTime int `json:"start_time"`
+	EndTime   int `json:"end_time"`
+}
+
+// NewScheduleInterval creates an interval from the given start and end of the day,
+// e.g. NewScheduleInterval(time.Monday, 9, 0, 17, 0) for Monday 9:00-17:00
+func NewScheduleInterval(day time.Weekday, startHour, startMinute, endHour, endMinute int) ScheduleInterval {
+	return ScheduleInterval{
+		StartTime: MinuteOfWeek(day, startHour, startMinute),
+		EndTime:   MinuteOfWeek(day, endHour, endMinute),
+	}
+}
+
+// Weekday returns the day of the week the interval starts
+func (i ScheduleInterval) Weekday() time.Weekday {
+	return time.Weekday(i.StartTime / (24 * 60))
+}
+
+// Duration returns the length of the interval
+func (i ScheduleInterval) Duration() time.Duration {
+	return time.Duration(i.EndTime-i.StartTime) * time.Minute
+}
+
+// Validate returns an error if the interval is empty or out of the week
+func (i ScheduleInterval) Validate() error {
+	if i.StartTime < 0 || i.EndTime > MinutesPerWeek || i.StartTime >= i.EndTime {
+		return fmt.Errorf("invalid schedule interval: %d-%d", i.StartTime, i.EndTime)
+	}
+	return nil
+}
+
+// Schedule is struct for business hours schedule payload
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/
+type Schedule struct {
+	ID        int64              `json:"id,omitempty"`
+	Name      string             `json:"name"`
+	TimeZone  string             `json:"time_zone"`
+	Intervals []ScheduleInterval `json:"intervals,omitempty"`
+	CreatedAt *time.Time         `json:"created_at,omitempty"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+}
+
+// Location returns the location of the time zone of the schedule.
+// Zendesk time zone names such as "Eastern Time (US & Canada)" are resolved to IANA time zones.
+func (s Schedule) Location() (*time.Location, error) {
+	return LoadTimezone(s.TimeZone)
+}
+
+// ScheduleHoliday is struct for holiday of a schedule.
+// StartDate and EndDate are in the format of ScheduleHolidayDateFormat, e.g. "2023-12-25".
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#holidays
+type ScheduleHoliday struct {
+	ID        int64  `json:"id,omitempty"`
+	Name      string `json:"name"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+// ScheduleAPI an interface containing all schedule related methods
+type ScheduleAPI interface {
+	GetSchedules(ctx context.Context) ([]Schedule, error)
+	GetSchedule(ctx context.Context, id int64) (Schedule, error)
+	CreateSchedule(ctx context.Context, schedule Schedule) (Schedule, error)
+	UpdateSchedule(ctx context.Context, id int64, schedule Schedule) (Schedule, error)
+	UpdateScheduleIntervals(ctx context.Context, id int64, intervals []ScheduleInterval) ([]ScheduleInterval, error)
+	DeleteSchedule(ctx context.Context, id int64) error
+	GetScheduleHolidays(ctx context.Context, scheduleID int64) ([]ScheduleHoliday, error)
+	GetScheduleHoliday(ctx context.Context, scheduleID, holidayID int64) (ScheduleHoliday, error)
+	CreateScheduleHoliday(ctx context.Context, scheduleID int64, holiday ScheduleHoliday) (ScheduleHoliday, error)
+	UpdateScheduleHoliday(ctx context.Context, scheduleID, holidayID int64, holiday ScheduleHoliday) (ScheduleHoliday, error)
+	DeleteScheduleHoliday(ctx context.Context, scheduleID, holidayID int64) error
+}
+
+// GetSchedules fetch schedule list
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#list-schedules
+func (z *Client) GetSchedules(ctx context.Context) ([]Schedule, error) {
+	var result struct {
+		Schedules []Schedule `json:"schedules"`
+	}
+
+	body, err := z.get(ctx, "/business_hours/schedules.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Schedules, nil
+}
+
+// GetSchedule gets a specified schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#show-schedule
+func (z *Client) GetSchedule(ctx context.Context, id int64) (Schedule, error) {
+	var result struct {
+		Schedule Schedule `json:"schedule"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/business_hours/schedules/%d.json", id))
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Schedule{}, err
+	}
+	return result.Schedule, nil
+}
+
+// CreateSchedule creates a new schedule.
+// Intervals of the new schedule default to Monday to Friday 8:00-17:00.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#create-schedule
+func (z *Client) CreateSchedule(ctx context.Context, schedule Schedule) (Schedule, error) {
+	var data, result struct {
+		Schedule Schedule `json:"schedule"`
+	}
+	data.Schedule = schedule
+
+	body, err := z.post(ctx, "/business_hours/schedules.json", data)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Schedule{}, err
+	}
+	return result.Schedule, nil
+}
+
+// UpdateSchedule updates a specified schedule.
+// Intervals are updated with UpdateScheduleIntervals.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#update-schedule
+func (z *Client) UpdateSchedule(ctx context.Context, id int64, schedule Schedule) (Schedule, error) {
+	var data, result struct {
+		Schedule Schedule `json:"schedule"`
+	}
+	data.Schedule = schedule
+
+	body, err := z.put(ctx, fmt.Sprintf("/business_hours/schedules/%d.json", id), data)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Schedule{}, err
+	}
+	return result.Schedule, nil
+}
+
+// UpdateScheduleIntervals replaces the intervals of a specified schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#update-intervals-for-a-schedule
+func (z *Client) UpdateScheduleIntervals(ctx context.Context, id int64, intervals []ScheduleInterval) ([]ScheduleInterval, error) {
+	for _, interval := range intervals {
+		if err := interval.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	type workweek struct {
+		Intervals []ScheduleInterval `json:"intervals"`
+	}
+	var data, result struct {
+		Workweek workweek `json:"workweek"`
+	}
+	data.Workweek.Intervals = intervals
+
+	body, err := z.put(ctx, fmt.Sprintf("/business_hours/schedules/%d/workweek.json", id), data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Workweek.Intervals, nil
+}
+
+// DeleteSchedule deletes a specified schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#delete-schedule
+func (z *Client) DeleteSchedule(ctx context.Context, id int64) error {
+	return z.delete(ctx, fmt.Sprintf("/business_hours/schedules/%d.json", id))
+}
+
+// GetScheduleHolidays fetch holidays of a schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#list-holidays-for-a-schedule
+func (z *Client) GetScheduleHolidays(ctx context.Context, scheduleID int64) ([]ScheduleHoliday, error) {
+	var result struct {
+		Holidays []ScheduleHoliday `json:"holidays"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays.json", scheduleID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Holidays, nil
+}
+
+// GetScheduleHoliday gets a specified holiday of a schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#show-holiday
+func (z *Client) GetScheduleHoliday(ctx context.Context, scheduleID, holidayID int64) (ScheduleHoliday, error) {
+	var result struct {
+		Holiday ScheduleHoliday `json:"holiday"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays/%d.json", scheduleID, holidayID))
+	if err != nil {
+		return ScheduleHoliday{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ScheduleHoliday{}, err
+	}
+	return result.Holiday, nil
+}
+
+// CreateScheduleHoliday adds a holiday to a schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#create-holiday
+func (z *Client) CreateScheduleHoliday(ctx context.Context, scheduleID int64, holiday ScheduleHoliday) (ScheduleHoliday, error) {
+	var data, result struct {
+		Holiday ScheduleHoliday `json:"holiday"`
+	}
+	data.Holiday = holiday
+
+	body, err := z.post(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays.json", scheduleID), data)
+	if err != nil {
+		return ScheduleHoliday{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ScheduleHoliday{}, err
+	}
+	return result.Holiday, nil
+}
+
+// UpdateScheduleHoliday updates a specified holiday of a schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#update-holiday
+func (z *Client) UpdateScheduleHoliday(ctx context.Context, scheduleID, holidayID int64, holiday ScheduleHoliday) (ScheduleHoliday, error) {
+	var data, result struct {
+		Holiday ScheduleHoliday `json:"holiday"`
+	}
+	data.Holiday = holiday
+
+	body, err := z.put(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays/%d.json", scheduleID, holidayID), data)
+	if err != nil {
+		return ScheduleHoliday{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ScheduleHoliday{}, err
+	}
+	return result.Holiday, nil
+}
+
+// DeleteScheduleHoliday deletes a specified holiday of a schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#delete-holiday
+func (z *Client) DeleteScheduleHoliday(ctx context.Context, scheduleID, holidayID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays/%d.json", scheduleID, holidayID))
+}
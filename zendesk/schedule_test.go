@@ -0,0 +1,220 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestGetSchedules(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "schedules.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	schedules, err := client.GetSchedules(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get schedules: %s", err)
+	}
+
+	if len(schedules) != 2 {
+		t.Fatalf("expected length of schedules is 2, but got %d", len(schedules))
+	}
+}
+
+func TestGetSchedule(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "schedule.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	schedule, err := client.GetSchedule(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to get schedule: %s", err)
+	}
+
+	if len(schedule.Intervals) != 5 {
+		t.Fatalf("expected length of intervals is 5, but got %d", len(schedule.Intervals))
+	}
+	if interval := schedule.Intervals[0]; interval != NewScheduleInterval(time.Monday, 9, 0, 17, 0) {
+		t.Fatalf("expected first interval is Monday 9:00-17:00, but got %v", interval)
+	}
+}
+
+func TestCreateSchedule(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "schedule.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	schedule, err := client.CreateSchedule(ctx, Schedule{
+		Name:     "East Coast",
+		TimeZone: "Eastern Time (US & Canada)",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create schedule: %s", err)
+	}
+
+	if schedule.ID != 1 {
+		t.Fatalf("expected id of schedule is 1, but got %d", schedule.ID)
+	}
+}
+
+func TestUpdateSchedule(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "schedule.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateSchedule(ctx, 1, Schedule{Name: "East Coast", TimeZone: "Eastern Time (US & Canada)"})
+	if err != nil {
+		t.Fatalf("Failed to update schedule: %s", err)
+	}
+}
+
+func TestUpdateScheduleIntervals(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/business_hours/schedules/1/workweek.json" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		var data struct {
+			Workweek struct {
+				Intervals []ScheduleInterval `json:"intervals"`
+			} `json:"workweek"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatal(err)
+		}
+		if len(data.Workweek.Intervals) != 2 || data.Workweek.Intervals[0].StartTime != 1860 {
+			t.Fatalf("unexpected intervals %v", data.Workweek.Intervals)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "schedule_workweek.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	intervals, err := client.UpdateScheduleIntervals(ctx, 1, []ScheduleInterval{
+		NewScheduleInterval(time.Monday, 7, 0, 15, 0),
+		NewScheduleInterval(time.Tuesday, 7, 0, 15, 0),
+	})
+	if err != nil {
+		t.Fatalf("Failed to update schedule intervals: %s", err)
+	}
+
+	if len(intervals) != 2 {
+		t.Fatalf("expected length of intervals is 2, but got %d", len(intervals))
+	}
+}
+
+func TestUpdateScheduleIntervalsInvalid(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("invalid intervals should not be sent")
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateScheduleIntervals(ctx, 1, []ScheduleInterval{{StartTime: 600, EndTime: 540}})
+	if err == nil {
+		t.Fatal("expected error for invalid interval")
+	}
+}
+
+func TestDeleteSchedule(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteSchedule(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to delete schedule: %s", err)
+	}
+}
+
+func TestGetScheduleHolidays(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "schedule_holidays.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	holidays, err := client.GetScheduleHolidays(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to get schedule holidays: %s", err)
+	}
+
+	if len(holidays) != 2 {
+		t.Fatalf("expected length of holidays is 2, but got %d", len(holidays))
+	}
+}
+
+func TestGetScheduleHoliday(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "schedule_holiday.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	holiday, err := client.GetScheduleHoliday(ctx, 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to get schedule holiday: %s", err)
+	}
+
+	if holiday.StartDate != "2023-12-25" {
+		t.Fatalf("expected start date of holiday is 2023-12-25, but got %s", holiday.StartDate)
+	}
+}
+
+func TestCreateScheduleHoliday(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "schedule_holiday.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateScheduleHoliday(ctx, 1, ScheduleHoliday{
+		Name:      "Christmas",
+		StartDate: "2023-12-25",
+		EndDate:   "2023-12-25",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create schedule holiday: %s", err)
+	}
+}
+
+func TestUpdateScheduleHoliday(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "schedule_holiday.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateScheduleHoliday(ctx, 1, 1, ScheduleHoliday{Name: "Christmas"})
+	if err != nil {
+		t.Fatalf("Failed to update schedule holiday: %s", err)
+	}
+}
+
+func TestDeleteScheduleHoliday(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteScheduleHoliday(ctx, 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to delete schedule holiday: %s", err)
+	}
+}
+
+func TestScheduleLocation(t *testing.T) {
+	loc, err := Schedule{TimeZone: "Eastern Time (US & Canada)"}.Location()
+	if err != nil {
+		t.Fatalf("Failed to load location: %s", err)
+	}
+	if loc.String() != "America/New_York" {
+		t.Fatalf("expected location is America/New_York, but got %s", loc)
+	}
+
+	loc, err = Schedule{TimeZone: "Europe/Berlin"}.Location()
+	if err != nil {
+		t.Fatalf("Failed to load location: %s", err)
+	}
+	if loc.String() != "Europe/Berlin" {
+		t.Fatalf("expected location is Europe/Berlin, but got %s", loc)
+	}
+}
@@ -0,0 +1,126 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Session is struct for user session payload
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/
+type Session struct {
+	ID              int64      `json:"id,omitempty"`
+	URL             string     `json:"url,omitempty"`
+	UserID          int64      `json:"user_id,omitempty"`
+	AuthenticatedAt *time.Time `json:"authenticated_at,omitempty"`
+	LastSeenAt      *time.Time `json:"last_seen_at,omitempty"`
+}
+
+// SessionAPI an interface containing all session related methods
+type SessionAPI interface {
+	GetSessions(ctx context.Context, opts *PageOptions) ([]Session, Page, error)
+	GetUserSessions(ctx context.Context, userID int64, opts *PageOptions) ([]Session, Page, error)
+	GetUserSession(ctx context.Context, userID, sessionID int64) (Session, error)
+	GetCurrentSession(ctx context.Context) (Session, error)
+	DeleteUserSession(ctx context.Context, userID, sessionID int64) error
+	DeleteUserSessions(ctx context.Context, userID int64) error
+	LogoutCurrentSession(ctx context.Context) error
+	GetSessionsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Session]
+	GetSessionsOBP(ctx context.Context, opts *OBPOptions) ([]Session, Page, error)
+	GetSessionsCBP(ctx context.Context, opts *CBPOptions) ([]Session, CursorPaginationMeta, error)
+	GetUserSessionsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Session]
+	GetUserSessionsOBP(ctx context.Context, opts *OBPOptions) ([]Session, Page, error)
+	GetUserSessionsCBP(ctx context.Context, opts *CBPOptions) ([]Session, CursorPaginationMeta, error)
+}
+
+// GetSessions fetch sessions of all users of the account.
+// Only admins can list sessions of other users.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#list-sessions
+func (z *Client) GetSessions(ctx context.Context, opts *PageOptions) ([]Session, Page, error) {
+	return z.getSessions(ctx, "/sessions.json", opts)
+}
+
+// GetUserSessions fetch sessions of a user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#list-sessions
+func (z *Client) GetUserSessions(ctx context.Context, userID int64, opts *PageOptions) ([]Session, Page, error) {
+	return z.getSessions(ctx, fmt.Sprintf("/users/%d/sessions.json", userID), opts)
+}
+
+func (z *Client) getSessions(ctx context.Context, path string, opts *PageOptions) ([]Session, Page, error) {
+	var data struct {
+		Sessions []Session `json:"sessions"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Sessions, data.Page, nil
+}
+
+// GetUserSession gets a specified session of a user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#show-session
+func (z *Client) GetUserSession(ctx context.Context, userID, sessionID int64) (Session, error) {
+	return z.getSession(ctx, fmt.Sprintf("/users/%d/sessions/%d.json", userID, sessionID))
+}
+
+// GetCurrentSession gets the session of the authenticated user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#show-the-currently-authenticated-session
+func (z *Client) GetCurrentSession(ctx context.Context) (Session, error) {
+	return z.getSession(ctx, "/users/me/session.json")
+}
+
+func (z *Client) getSession(ctx context.Context, path string) (Session, error) {
+	var result struct {
+		Session Session `json:"session"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return Session{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Session{}, err
+	}
+	return result.Session, nil
+}
+
+// DeleteUserSession deletes a specified session of a user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#delete-session
+func (z *Client) DeleteUserSession(ctx context.Context, userID, sessionID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/users/%d/sessions/%d.json", userID, sessionID))
+}
+
+// DeleteUserSessions deletes all sessions of a user, which logs the user out everywhere
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#bulk-delete-sessions
+func (z *Client) DeleteUserSessions(ctx context.Context, userID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/users/%d/sessions.json", userID))
+}
+
+// LogoutCurrentSession deletes the session of the authenticated user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#delete-the-authenticated-session
+func (z *Client) LogoutCurrentSession(ctx context.Context) error {
+	return z.delete(ctx, "/users/me/logout.json")
+}
@@ -0,0 +1,115 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestGetSessions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sessions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sessions, _, err := client.GetSessions(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get sessions: %s", err)
+	}
+
+	if len(sessions) != 2 {
+		t.Fatalf("expected length of sessions is 2, but got %d", len(sessions))
+	}
+	expected := time.Date(2023, 2, 1, 15, 30, 0, 0, time.UTC)
+	if sessions[1].LastSeenAt == nil || !sessions[1].LastSeenAt.Equal(expected) {
+		t.Fatalf("expected last seen at %s, but got %v", expected, sessions[1].LastSeenAt)
+	}
+}
+
+func TestGetUserSessions(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/12/sessions.json" {
+			t.Fatalf("unexpected request path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "sessions.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sessions, _, err := client.GetUserSessions(ctx, 12, nil)
+	if err != nil {
+		t.Fatalf("Failed to get user sessions: %s", err)
+	}
+
+	if len(sessions) != 2 {
+		t.Fatalf("expected length of sessions is 2, but got %d", len(sessions))
+	}
+}
+
+func TestGetUserSession(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "session.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	session, err := client.GetUserSession(ctx, 12, 3432)
+	if err != nil {
+		t.Fatalf("Failed to get user session: %s", err)
+	}
+
+	if session.ID != 3432 {
+		t.Fatalf("expected id of session is 3432, but got %d", session.ID)
+	}
+}
+
+func TestGetCurrentSession(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/me/session.json" {
+			t.Fatalf("unexpected request path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "session.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	session, err := client.GetCurrentSession(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get current session: %s", err)
+	}
+
+	if session.UserID != 12 {
+		t.Fatalf("expected user id of session is 12, but got %d", session.UserID)
+	}
+}
+
+func TestDeleteUserSessions(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/users/12/sessions.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteUserSessions(ctx, 12)
+	if err != nil {
+		t.Fatalf("Failed to delete user sessions: %s", err)
+	}
+}
+
+func TestLogoutCurrentSession(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/users/me/logout.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.LogoutCurrentSession(ctx)
+	if err != nil {
+		t.Fatalf("Failed to logout current session: %s", err)
+	}
+}
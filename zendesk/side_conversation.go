@@ -0,0 +1,317 @@
+package zendesk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"time"
+)
+
+// Side conversation states
+const (
+	SideConversationStateOpen   = "open"
+	SideConversationStateClosed = "closed"
+)
+
+// SideConversationParticipant is a participant of a side conversation.
+// Which fields are set depends on the channel of the participant;
+// use the New*SideConversationParticipant functions to build one.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation/#participants
+type SideConversationParticipant struct {
+	UserID           int64  `json:"user_id,omitempty"`
+	Name             string `json:"name,omitempty"`
+	Email            string `json:"email,omitempty"`
+	SlackWorkspaceID string `json:"slack_workspace_id,omitempty"`
+	SlackChannelID   string `json:"slack_channel_id,omitempty"`
+	SupportGroupID   int64  `json:"support_group_id,omitempty"`
+	SupportAgentID   int64  `json:"support_agent_id,omitempty"`
+}
+
+// NewEmailSideConversationParticipant returns a participant reached by email
+func NewEmailSideConversationParticipant(email, name string) SideConversationParticipant {
+	return SideConversationParticipant{Email: email, Name: name}
+}
+
+// NewSlackSideConversationParticipant returns a Slack channel participant
+func NewSlackSideConversationParticipant(workspaceID, channelID string) SideConversationParticipant {
+	return SideConversationParticipant{SlackWorkspaceID: workspaceID, SlackChannelID: channelID}
+}
+
+// NewChildTicketSideConversationParticipant returns a participant which makes
+// the side conversation create a child ticket assigned to the group and,
+// optionally, the agent
+func NewChildTicketSideConversationParticipant(groupID, agentID int64) SideConversationParticipant {
+	return SideConversationParticipant{SupportGroupID: groupID, SupportAgentID: agentID}
+}
+
+// SideConversationAttachment is an attachment of a side conversation message
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation_attachment/
+type SideConversationAttachment struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	ContentURL  string `json:"content_url,omitempty"`
+	Size        int64  `json:"size,omitempty"`
+	Width       int64  `json:"width,omitempty"`
+	Height      int64  `json:"height,omitempty"`
+}
+
+// SideConversationMessage is a message sent in a side conversation
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation/#messages
+type SideConversationMessage struct {
+	Subject     string                        `json:"subject,omitempty"`
+	PreviewText string                        `json:"preview_text,omitempty"`
+	Body        string                        `json:"body,omitempty"`
+	HTMLBody    string                        `json:"html_body,omitempty"`
+	From        *SideConversationParticipant  `json:"from,omitempty"`
+	To          []SideConversationParticipant `json:"to,omitempty"`
+	ExternalIDs map[string]interface{}        `json:"external_ids,omitempty"`
+
+	// AttachmentIDs is POST only. IDs are returned by UploadSideConversationAttachment
+	AttachmentIDs []string `json:"attachment_ids,omitempty"`
+
+	Attachments []SideConversationAttachment `json:"attachments,omitempty"`
+}
+
+// SideConversation is struct for side conversation payload
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation/
+type SideConversation struct {
+	ID             string                        `json:"id,omitempty"`
+	URL            string                        `json:"url,omitempty"`
+	TicketID       int64                         `json:"ticket_id,omitempty"`
+	Subject        string                        `json:"subject,omitempty"`
+	PreviewText    string                        `json:"preview_text,omitempty"`
+	State          string                        `json:"state,omitempty"`
+	Participants   []SideConversationParticipant `json:"participants,omitempty"`
+	ExternalIDs    map[string]interface{}        `json:"external_ids,omitempty"`
+	CreatedAt      *time.Time                    `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time                    `json:"updated_at,omitempty"`
+	MessageAddedAt *time.Time                    `json:"message_added_at,omitempty"`
+	StateUpdatedAt *time.Time                    `json:"state_updated_at,omitempty"`
+}
+
+// SideConversationEvent is an event of a side conversation,
+// e.g. its creation, a reply or an update
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation_event/
+type SideConversationEvent struct {
+	ID                 string                      `json:"id,omitempty"`
+	SideConversationID string                      `json:"side_conversation_id,omitempty"`
+	TicketID           int64                       `json:"ticket_id,omitempty"`
+	Type               string                      `json:"type,omitempty"`
+	Via                string                      `json:"via,omitempty"`
+	Actor              SideConversationParticipant `json:"actor,omitempty"`
+	Message            *SideConversationMessage    `json:"message,omitempty"`
+	Updates            map[string]interface{}      `json:"updates,omitempty"`
+	CreatedAt          *time.Time                  `json:"created_at,omitempty"`
+}
+
+// SideConversationAPI an interface containing all side conversation related methods
+type SideConversationAPI interface {
+	GetSideConversations(ctx context.Context, ticketID int64, opts *PageOptions) ([]SideConversation, Page, error)
+	GetSideConversation(ctx context.Context, ticketID int64, id string) (SideConversation, error)
+	CreateSideConversation(ctx context.Context, ticketID int64, message SideConversationMessage) (SideConversation, error)
+	ReplySideConversation(ctx context.Context, ticketID int64, id string, message SideConversationMessage) (SideConversationEvent, error)
+	UpdateSideConversation(ctx context.Context, ticketID int64, id string, sideConversation SideConversation) (SideConversation, error)
+	GetSideConversationEvents(ctx context.Context, ticketID int64, id string) ([]SideConversationEvent, error)
+	UploadSideConversationAttachment(ctx context.Context, filename string, r io.Reader) (SideConversationAttachment, error)
+}
+
+// GetSideConversations fetch side conversation list of a ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation/#list-side-conversations
+func (z *Client) GetSideConversations(ctx context.Context, ticketID int64, opts *PageOptions) ([]SideConversation, Page, error) {
+	var data struct {
+		SideConversations []SideConversation `json:"side_conversations"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/tickets/%d/side_conversations", ticketID), tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.SideConversations, data.Page, nil
+}
+
+// GetSideConversation gets a specified side conversation
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation/#show-side-conversation
+func (z *Client) GetSideConversation(ctx context.Context, ticketID int64, id string) (SideConversation, error) {
+	var result struct {
+		SideConversation SideConversation `json:"side_conversation"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/tickets/%d/side_conversations/%s", ticketID, id))
+	if err != nil {
+		return SideConversation{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SideConversation{}, err
+	}
+	return result.SideConversation, nil
+}
+
+// CreateSideConversation starts a side conversation on a ticket.
+// The channel (email, Slack or child ticket) is selected by the participants in message.To.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation/#create-side-conversation
+func (z *Client) CreateSideConversation(ctx context.Context, ticketID int64, message SideConversationMessage) (SideConversation, error) {
+	var data struct {
+		Message SideConversationMessage `json:"message"`
+	}
+	var result struct {
+		SideConversation SideConversation `json:"side_conversation"`
+	}
+	data.Message = message
+
+	body, err := z.post(ctx, fmt.Sprintf("/tickets/%d/side_conversations", ticketID), data)
+	if err != nil {
+		return SideConversation{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SideConversation{}, err
+	}
+	return result.SideConversation, nil
+}
+
+// ReplySideConversation replies to a side conversation and returns the reply event
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation/#reply-to-side-conversation
+func (z *Client) ReplySideConversation(ctx context.Context, ticketID int64, id string, message SideConversationMessage) (SideConversationEvent, error) {
+	var data struct {
+		Message SideConversationMessage `json:"message"`
+	}
+	var result struct {
+		Event SideConversationEvent `json:"event"`
+	}
+	data.Message = message
+
+	body, err := z.post(ctx, fmt.Sprintf("/tickets/%d/side_conversations/%s/reply", ticketID, id), data)
+	if err != nil {
+		return SideConversationEvent{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SideConversationEvent{}, err
+	}
+	return result.Event, nil
+}
+
+// UpdateSideConversation updates the state or subject of a side conversation
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation/#update-side-conversation
+func (z *Client) UpdateSideConversation(ctx context.Context, ticketID int64, id string, sideConversation SideConversation) (SideConversation, error) {
+	var data, result struct {
+		SideConversation SideConversation `json:"side_conversation"`
+	}
+	data.SideConversation = sideConversation
+
+	body, err := z.put(ctx, fmt.Sprintf("/tickets/%d/side_conversations/%s", ticketID, id), data)
+	if err != nil {
+		return SideConversation{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SideConversation{}, err
+	}
+	return result.SideConversation, nil
+}
+
+// GetSideConversationEvents fetch event list of a side conversation
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation_event/#list-side-conversation-events
+func (z *Client) GetSideConversationEvents(ctx context.Context, ticketID int64, id string) ([]SideConversationEvent, error) {
+	var result struct {
+		Events []SideConversationEvent `json:"events"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/tickets/%d/side_conversations/%s/events", ticketID, id))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Events, nil
+}
+
+// UploadSideConversationAttachment uploads a file which can be attached to a
+// side conversation message by setting its ID in AttachmentIDs
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation_attachment/#create-side-conversation-attachment
+func (z *Client) UploadSideConversationAttachment(ctx context.Context, filename string, r io.Reader) (SideConversationAttachment, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	part, err := mw.CreateFormFile("file", filename)
+	if err != nil {
+		return SideConversationAttachment{}, err
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return SideConversationAttachment{}, err
+	}
+	if err := mw.Close(); err != nil {
+		return SideConversationAttachment{}, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, z.baseURL.String()+"/tickets/side_conversations/attachments", &buf)
+	if err != nil {
+		return SideConversationAttachment{}, err
+	}
+
+	req = z.prepareRequest(ctx, req)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	resp, err := z.httpClient.Do(req)
+	if err != nil {
+		return SideConversationAttachment{}, err
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return SideConversationAttachment{}, err
+	}
+
+	if !(resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated) {
+		return SideConversationAttachment{}, Error{
+			body: body,
+			resp: resp,
+		}
+	}
+
+	var result struct {
+		Attachment SideConversationAttachment `json:"attachment"`
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SideConversationAttachment{}, err
+	}
+	return result.Attachment, nil
+}
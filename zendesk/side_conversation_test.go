@@ -0,0 +1,162 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGetSideConversations(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "side_conversations.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sideConversations, _, err := client.GetSideConversations(ctx, 123, nil)
+	if err != nil {
+		t.Fatalf("Failed to get side conversations: %s", err)
+	}
+
+	if len(sideConversations) != 2 {
+		t.Fatalf("expected length of side conversations is %d, but got %d", 2, len(sideConversations))
+	}
+	if p := sideConversations[1].Participants[0]; p.SlackChannelID != "C0123" {
+		t.Fatalf("expected slack channel participant, but got %v", p)
+	}
+}
+
+func TestGetSideConversation(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "side_conversation.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sideConversation, err := client.GetSideConversation(ctx, 123, "8566255a-1d7e-11ea-a5a8-d1e7e0c0a6f4")
+	if err != nil {
+		t.Fatalf("Failed to get side conversation: %s", err)
+	}
+
+	if sideConversation.State != SideConversationStateOpen {
+		t.Fatalf("expected state %s, but got %s", SideConversationStateOpen, sideConversation.State)
+	}
+}
+
+func TestCreateSideConversation(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Message SideConversationMessage `json:"message"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		if len(data.Message.To) != 1 || data.Message.To[0].SupportGroupID != 456 {
+			t.Fatalf("unexpected participants in request body: %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "side_conversation.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sideConversation, err := client.CreateSideConversation(ctx, 123, SideConversationMessage{
+		Subject: "Child ticket",
+		Body:    "Please take a look",
+		To: []SideConversationParticipant{
+			NewChildTicketSideConversationParticipant(456, 0),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create side conversation: %s", err)
+	}
+
+	if sideConversation.ID != "8566255a-1d7e-11ea-a5a8-d1e7e0c0a6f4" {
+		t.Fatalf("unexpected side conversation id: %s", sideConversation.ID)
+	}
+}
+
+func TestReplySideConversation(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "side_conversation_reply.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	event, err := client.ReplySideConversation(ctx, 123, "8566255a-1d7e-11ea-a5a8-d1e7e0c0a6f4", SideConversationMessage{
+		Body:          "Any update?",
+		To:            []SideConversationParticipant{NewEmailSideConversationParticipant("warehouse@example.com", "Warehouse")},
+		AttachmentIDs: []string{"0cfbf7f8-1d7f-11ea-a5a8-c1e1e0c0a6f4"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to reply side conversation: %s", err)
+	}
+
+	if event.Type != "reply" || event.Message == nil || len(event.Message.Attachments) != 1 {
+		t.Fatalf("unexpected reply event: %v", event)
+	}
+}
+
+func TestUpdateSideConversation(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "side_conversation.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sideConversation, err := client.UpdateSideConversation(ctx, 123, "8566255a-1d7e-11ea-a5a8-d1e7e0c0a6f4", SideConversation{
+		State: SideConversationStateClosed,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update side conversation: %s", err)
+	}
+
+	if sideConversation.State != SideConversationStateClosed {
+		t.Fatalf("expected state %s, but got %s", SideConversationStateClosed, sideConversation.State)
+	}
+}
+
+func TestGetSideConversationEvents(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "side_conversation_events.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	events, err := client.GetSideConversationEvents(ctx, 123, "8566255a-1d7e-11ea-a5a8-d1e7e0c0a6f4")
+	if err != nil {
+		t.Fatalf("Failed to get side conversation events: %s", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("expected length of events is %d, but got %d", 2, len(events))
+	}
+	if events[1].Updates["state"] != SideConversationStateClosed {
+		t.Fatalf("unexpected updates: %v", events[1].Updates)
+	}
+}
+
+func TestUploadSideConversationAttachment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Failed to read multipart file: %s", err)
+		}
+		defer file.Close()
+
+		content, _ := io.ReadAll(file)
+		if header.Filename != "invoice.pdf" || string(content) != "%PDF-1.4" {
+			t.Fatalf("unexpected file %s: %s", header.Filename, content)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "side_conversation_attachment.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachment, err := client.UploadSideConversationAttachment(ctx, "invoice.pdf", strings.NewReader("%PDF-1.4"))
+	if err != nil {
+		t.Fatalf("Failed to upload side conversation attachment: %s", err)
+	}
+
+	if attachment.ID != "0cfbf7f8-1d7f-11ea-a5a8-c1e1e0c0a6f4" {
+		t.Fatalf("unexpected attachment id: %s", attachment.ID)
+	}
+}
@@ -0,0 +1,111 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Skip is struct for ticket skip payload.
+// A skip is recorded when an agent skips a ticket in play mode.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_skips/
+type Skip struct {
+	ID        int64      `json:"id,omitempty"`
+	TicketID  int64      `json:"ticket_id,omitempty"`
+	UserID    int64      `json:"user_id,omitempty"`
+	Reason    string     `json:"reason,omitempty"`
+	Ticket    *Ticket    `json:"ticket,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// SkipListOptions is options for GetSkips, GetUserSkips and GetTicketSkips
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_skips/#list-ticket-skips
+type SkipListOptions struct {
+	PageOptions
+
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// SkipAPI an interface containing all ticket skip related methods
+type SkipAPI interface {
+	GetSkips(ctx context.Context, opts *SkipListOptions) ([]Skip, Page, error)
+	GetUserSkips(ctx context.Context, userID int64, opts *SkipListOptions) ([]Skip, Page, error)
+	GetTicketSkips(ctx context.Context, ticketID int64, opts *SkipListOptions) ([]Skip, Page, error)
+	CreateSkip(ctx context.Context, ticketID int64, reason string) (Skip, error)
+	GetUserSkipsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Skip]
+	GetUserSkipsOBP(ctx context.Context, opts *OBPOptions) ([]Skip, Page, error)
+	GetUserSkipsCBP(ctx context.Context, opts *CBPOptions) ([]Skip, CursorPaginationMeta, error)
+	GetTicketSkipsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Skip]
+	GetTicketSkipsOBP(ctx context.Context, opts *OBPOptions) ([]Skip, Page, error)
+	GetTicketSkipsCBP(ctx context.Context, opts *CBPOptions) ([]Skip, CursorPaginationMeta, error)
+}
+
+// GetSkips fetch skips of the authenticated user, or of all agents if the user is an admin
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_skips/#list-ticket-skips
+func (z *Client) GetSkips(ctx context.Context, opts *SkipListOptions) ([]Skip, Page, error) {
+	return z.getSkips(ctx, "/skips.json", opts)
+}
+
+// GetUserSkips fetch skips recorded by a user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_skips/#list-ticket-skips
+func (z *Client) GetUserSkips(ctx context.Context, userID int64, opts *SkipListOptions) ([]Skip, Page, error) {
+	return z.getSkips(ctx, fmt.Sprintf("/users/%d/skips.json", userID), opts)
+}
+
+// GetTicketSkips fetch skips recorded on a ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_skips/#list-ticket-skips
+func (z *Client) GetTicketSkips(ctx context.Context, ticketID int64, opts *SkipListOptions) ([]Skip, Page, error) {
+	return z.getSkips(ctx, fmt.Sprintf("/tickets/%d/skips.json", ticketID), opts)
+}
+
+func (z *Client) getSkips(ctx context.Context, path string, opts *SkipListOptions) ([]Skip, Page, error) {
+	var data struct {
+		Skips []Skip `json:"skips"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &SkipListOptions{}
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Skips, data.Page, nil
+}
+
+// CreateSkip records a skip of the ticket by the authenticated user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_skips/#record-a-new-skip-for-the-current-user
+func (z *Client) CreateSkip(ctx context.Context, ticketID int64, reason string) (Skip, error) {
+	var data, result struct {
+		Skip Skip `json:"skip"`
+	}
+	data.Skip = Skip{TicketID: ticketID, Reason: reason}
+
+	body, err := z.post(ctx, "/skips.json", data)
+	if err != nil {
+		return Skip{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Skip{}, err
+	}
+	return result.Skip, nil
+}
@@ -0,0 +1,113 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetSkips(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "skips.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	skips, _, err := client.GetSkips(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get skips: %s", err)
+	}
+
+	if len(skips) != 2 {
+		t.Fatalf("expected length of skips is 2, but got %d", len(skips))
+	}
+	if skips[0].Ticket == nil || skips[0].Ticket.ID != 123 {
+		t.Fatalf("expected skipped ticket 123, but got %v", skips[0].Ticket)
+	}
+}
+
+func TestGetUserSkips(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/456/skips.json" || r.URL.Query().Get("sort_order") != "desc" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodGet, "skips.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	skips, _, err := client.GetUserSkips(ctx, 456, &SkipListOptions{SortOrder: "desc"})
+	if err != nil {
+		t.Fatalf("Failed to get user skips: %s", err)
+	}
+
+	if len(skips) != 2 {
+		t.Fatalf("expected length of skips is 2, but got %d", len(skips))
+	}
+}
+
+func TestGetTicketSkips(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "skips.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	skips, _, err := client.GetTicketSkips(ctx, 123, nil)
+	if err != nil {
+		t.Fatalf("Failed to get ticket skips: %s", err)
+	}
+
+	if len(skips) != 2 {
+		t.Fatalf("expected length of skips is 2, but got %d", len(skips))
+	}
+}
+
+func TestGetTicketSkipsIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "skips.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := NewPaginationOptions()
+	opts.IsCBP = false
+	opts.Id = 123
+
+	it := client.GetTicketSkipsIterator(ctx, opts)
+	skips, err := it.GetNext()
+	if err != nil {
+		t.Fatalf("Failed to get ticket skips: %s", err)
+	}
+
+	if len(skips) != 2 {
+		t.Fatalf("expected length of skips is 2, but got %d", len(skips))
+	}
+}
+
+func TestCreateSkip(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Skip Skip `json:"skip"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		if data.Skip.TicketID != 123 || data.Skip.Reason != "I have no idea." {
+			t.Fatalf("unexpected request body: %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "skip.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	skip, err := client.CreateSkip(ctx, 123, "I have no idea.")
+	if err != nil {
+		t.Fatalf("Failed to create skip: %s", err)
+	}
+
+	if skip.ID != 3 {
+		t.Fatalf("expected id of skip is 3, but got %d", skip.ID)
+	}
+}
@@ -63,6 +63,115 @@ type SLAPolicyListOptions struct {
 	SortOrder string `url:"sort_order,omitempty"`
 }
 
+// SLAPolicyDefinitionOperator is an operator which can be used with a filter field
+type SLAPolicyDefinitionOperator struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// SLAPolicyDefinitionValue is a value which can be set to a filter field.
+// Value is converted to string because Zendesk sends strings or numbers depending on the field.
+type SLAPolicyDefinitionValue struct {
+	Title   string `json:"title"`
+	Value   string `json:"value"`
+	Enabled bool   `json:"enabled"`
+}
+
+// UnmarshalJSON is unmarshaller for SLAPolicyDefinitionValue
+func (v *SLAPolicyDefinitionValue) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Title   string     `json:"title"`
+		Value   AuditValue `json:"value"`
+		Enabled bool       `json:"enabled"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	v.Title = raw.Title
+	v.Value = raw.Value.String()
+	v.Enabled = raw.Enabled
+	return nil
+}
+
+// SLAPolicyDefinition is a field which can be used in the filter of SLA policies
+type SLAPolicyDefinition struct {
+	Subject    string                        `json:"subject"`
+	Title      string                        `json:"title"`
+	Group      string                        `json:"group,omitempty"`
+	Nullable   bool                          `json:"nullable"`
+	Repeatable bool                          `json:"repeatable"`
+	Operators  []SLAPolicyDefinitionOperator `json:"operators"`
+
+	// Values is empty for free-form fields such as current_tags
+	Values []SLAPolicyDefinitionValue `json:"values,omitempty"`
+}
+
+// SLAPolicyDefinitions is the fields which can be used in Filter.All and Filter.Any of SLA policies
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/sla_policies/#retrieve-supported-filter-definition-items
+type SLAPolicyDefinitions struct {
+	All []SLAPolicyDefinition `json:"all"`
+	Any []SLAPolicyDefinition `json:"any"`
+}
+
+// Validate checks the fields, operators and values of the filter of the policy
+// and returns SLAPolicyValidationError describing every invalid condition
+func (d SLAPolicyDefinitions) Validate(slaPolicy SLAPolicy) error {
+	var errs []string
+	errs = append(errs, validateSLAPolicyFilters("all", slaPolicy.Filter.All, d.All)...)
+	errs = append(errs, validateSLAPolicyFilters("any", slaPolicy.Filter.Any, d.Any)...)
+	if len(errs) > 0 {
+		return &SLAPolicyValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func validateSLAPolicyFilters(name string, filters []SLAPolicyFilter, definitions []SLAPolicyDefinition) []string {
+	var errs []string
+	for i, f := range filters {
+		prefix := fmt.Sprintf("filter.%s[%d]", name, i)
+
+		var definition *SLAPolicyDefinition
+		for j := range definitions {
+			if definitions[j].Subject == f.Field {
+				definition = &definitions[j]
+				break
+			}
+		}
+		if definition == nil {
+			errs = append(errs, fmt.Sprintf("%s: unknown field %q", prefix, f.Field))
+			continue
+		}
+
+		validOperator := false
+		for _, o := range definition.Operators {
+			if o.Value == f.Operator {
+				validOperator = true
+				break
+			}
+		}
+		if !validOperator {
+			errs = append(errs, fmt.Sprintf("%s: invalid operator %q for field %q", prefix, f.Operator, f.Field))
+		}
+
+		if len(definition.Values) == 0 || (f.Value == "" && definition.Nullable) {
+			continue
+		}
+		validValue := false
+		for _, v := range definition.Values {
+			if v.Value == f.Value {
+				validValue = true
+				break
+			}
+		}
+		if !validValue {
+			errs = append(errs, fmt.Sprintf("%s: invalid value %q for field %q", prefix, f.Value, f.Field))
+		}
+	}
+	return errs
+}
+
 // SLAPolicyAPI an interface containing all slaPolicy related methods
 type SLAPolicyAPI interface {
 	GetSLAPolicies(ctx context.Context, opts *SLAPolicyListOptions) ([]SLAPolicy, Page, error)
@@ -70,6 +179,9 @@ type SLAPolicyAPI interface {
 	GetSLAPolicy(ctx context.Context, id int64) (SLAPolicy, error)
 	UpdateSLAPolicy(ctx context.Context, id int64, slaPolicy SLAPolicy) (SLAPolicy, error)
 	DeleteSLAPolicy(ctx context.Context, id int64) error
+	ReorderSLAPolicies(ctx context.Context, ids []int64) error
+	GetSLAPolicyDefinitions(ctx context.Context) (SLAPolicyDefinitions, error)
+	ValidateSLAPolicy(ctx context.Context, slaPolicy SLAPolicy) error
 	GetSLAPoliciesIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SLAPolicy]
 	GetSLAPoliciesOBP(ctx context.Context, opts *OBPOptions) ([]SLAPolicy, Page, error)
 	GetSLAPoliciesCBP(ctx context.Context, opts *CBPOptions) ([]SLAPolicy, CursorPaginationMeta, error)
@@ -106,7 +218,9 @@ func (z *Client) GetSLAPolicies(ctx context.Context, opts *SLAPolicyListOptions)
 	return data.SLAPolicies, data.Page, nil
 }
 
-// CreateSLAPolicy creates new slaPolicy
+// CreateSLAPolicy creates new slaPolicy.
+// The filter is validated against the SLA policy definitions cached on the client before the policy is sent.
+// The definitions are fetched once if they are not cached yet, and the policy is sent without validation if fetching them fails.
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/slas/policies#create-slaPolicy
 func (z *Client) CreateSLAPolicy(ctx context.Context, slaPolicy SLAPolicy) (SLAPolicy, error) {
@@ -114,6 +228,9 @@ func (z *Client) CreateSLAPolicy(ctx context.Context, slaPolicy SLAPolicy) (SLAP
 		SLAPolicy SLAPolicy `json:"sla_policy"`
 	}
 
+	if err := z.validateSLAPolicyFilter(ctx, slaPolicy); err != nil {
+		return SLAPolicy{}, err
+	}
 	data.SLAPolicy = slaPolicy
 
 	body, err := z.post(ctx, "/slas/policies.json", data)
@@ -150,7 +267,9 @@ func (z *Client) GetSLAPolicy(ctx context.Context, id int64) (SLAPolicy, error)
 	return result.SLAPolicy, nil
 }
 
-// UpdateSLAPolicy updates the specified slaPolicy and returns the updated one
+// UpdateSLAPolicy updates the specified slaPolicy and returns the updated one.
+// The filter is validated against the SLA policy definitions cached on the client before the policy is sent.
+// The definitions are fetched once if they are not cached yet, and the policy is sent without validation if fetching them fails.
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/slas/policies#update-slaPolicy
 func (z *Client) UpdateSLAPolicy(ctx context.Context, id int64, slaPolicy SLAPolicy) (SLAPolicy, error) {
@@ -158,6 +277,9 @@ func (z *Client) UpdateSLAPolicy(ctx context.Context, id int64, slaPolicy SLAPol
 		SLAPolicy SLAPolicy `json:"sla_policy"`
 	}
 
+	if err := z.validateSLAPolicyFilter(ctx, slaPolicy); err != nil {
+		return SLAPolicy{}, err
+	}
 	data.SLAPolicy = slaPolicy
 
 	body, err := z.put(ctx, fmt.Sprintf("/slas/policies/%d.json", id), data)
@@ -184,3 +306,80 @@ func (z *Client) DeleteSLAPolicy(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+// ReorderSLAPolicies sets the order of SLA policies. ids must contain all the policies.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/sla_policies/#reorder-sla-policies
+func (z *Client) ReorderSLAPolicies(ctx context.Context, ids []int64) error {
+	var data struct {
+		SLAPolicyIDs []int64 `json:"sla_policy_ids"`
+	}
+	data.SLAPolicyIDs = ids
+
+	_, err := z.put(ctx, "/slas/policies/reorder.json", data)
+	return err
+}
+
+// GetSLAPolicyDefinitions returns the fields, operators and values which can be used in the filter of SLA policies
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/sla_policies/#retrieve-supported-filter-definition-items
+func (z *Client) GetSLAPolicyDefinitions(ctx context.Context) (SLAPolicyDefinitions, error) {
+	var result struct {
+		Definitions SLAPolicyDefinitions `json:"definitions"`
+	}
+
+	body, err := z.get(ctx, "/slas/policies/definitions.json")
+	if err != nil {
+		return SLAPolicyDefinitions{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SLAPolicyDefinitions{}, err
+	}
+
+	z.slaPolicyDefinitionsMu.Lock()
+	z.slaPolicyDefinitions = &result.Definitions
+	z.slaPolicyDefinitionsMu.Unlock()
+
+	return result.Definitions, nil
+}
+
+// ValidateSLAPolicy validates the filter of the policy against the definitions of the account.
+// The definitions are cached on the client and fetched only if they are not cached yet.
+// It returns SLAPolicyValidationError if the filter has invalid conditions.
+func (z *Client) ValidateSLAPolicy(ctx context.Context, slaPolicy SLAPolicy) error {
+	definitions, err := z.cachedSLAPolicyDefinitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	return definitions.Validate(slaPolicy)
+}
+
+// cachedSLAPolicyDefinitions returns the cached definitions or fetches them
+func (z *Client) cachedSLAPolicyDefinitions(ctx context.Context) (SLAPolicyDefinitions, error) {
+	z.slaPolicyDefinitionsMu.Lock()
+	definitions := z.slaPolicyDefinitions
+	z.slaPolicyDefinitionsMu.Unlock()
+
+	if definitions != nil {
+		return *definitions, nil
+	}
+	return z.GetSLAPolicyDefinitions(ctx)
+}
+
+// validateSLAPolicyFilter validates the filter before create and update.
+// Policies without filter are not validated, and a failure to fetch the definitions
+// does not block the write as the server validates the policy as well.
+func (z *Client) validateSLAPolicyFilter(ctx context.Context, slaPolicy SLAPolicy) error {
+	if len(slaPolicy.Filter.All) == 0 && len(slaPolicy.Filter.Any) == 0 {
+		return nil
+	}
+
+	definitions, err := z.cachedSLAPolicyDefinitions(ctx)
+	if err != nil {
+		return nil
+	}
+	return definitions.Validate(slaPolicy)
+}
@@ -1,8 +1,11 @@
 package zendesk
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -134,3 +137,169 @@ func TestDeleteSLAPolicyFailure(t *testing.T) {
 		t.Fatal("Client did not return error when api failed")
 	}
 }
+
+func TestReorderSLAPolicies(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || r.URL.Path != "/slas/policies/reorder.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if string(body) != `{"sla_policy_ids":[3,1,2]}` {
+			t.Fatalf("unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ReorderSLAPolicies(ctx, []int64{3, 1, 2})
+	if err != nil {
+		t.Fatalf("Failed to reorder sla policies: %s", err)
+	}
+}
+
+func TestGetSLAPolicyDefinitions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sla_policy_definitions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	definitions, err := client.GetSLAPolicyDefinitions(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get sla policy definitions: %s", err)
+	}
+
+	if len(definitions.All) != 3 || len(definitions.Any) != 1 {
+		t.Fatalf("expected 3 all and 1 any definitions, but got %d and %d", len(definitions.All), len(definitions.Any))
+	}
+	if value := definitions.All[1].Values[0].Value; value != "360000000001" {
+		t.Fatalf("expected numeric value to be converted to string, but got %s", value)
+	}
+}
+
+func TestSLAPolicyDefinitionsValidate(t *testing.T) {
+	var definitions struct {
+		Definitions SLAPolicyDefinitions `json:"definitions"`
+	}
+	if err := json.Unmarshal(readFixture(filepath.Join(http.MethodGet, "sla_policy_definitions.json")), &definitions); err != nil {
+		t.Fatal(err)
+	}
+
+	valid := SLAPolicy{}
+	valid.Filter.All = []SLAPolicyFilter{
+		{Field: "type", Operator: "is", Value: "incident"},
+		{Field: "group_id", Operator: "is_not", Value: ""},
+		{Field: "current_tags", Operator: "includes", Value: "vip"},
+	}
+	valid.Filter.Any = []SLAPolicyFilter{{Field: "type", Operator: "is", Value: "problem"}}
+	if err := definitions.Definitions.Validate(valid); err != nil {
+		t.Fatalf("expected policy to be valid: %s", err)
+	}
+
+	invalid := SLAPolicy{}
+	invalid.Filter.All = []SLAPolicyFilter{
+		{Field: "type", Operator: "less_than", Value: "incident"},
+		{Field: "unknown", Operator: "is", Value: "x"},
+	}
+	invalid.Filter.Any = []SLAPolicyFilter{{Field: "type", Operator: "is", Value: "question"}}
+	err := definitions.Definitions.Validate(invalid)
+	validationErr, ok := err.(*SLAPolicyValidationError)
+	if !ok {
+		t.Fatalf("unexpected error type: %v", err)
+	}
+
+	expected := []string{
+		`filter.all[0]: invalid operator "less_than" for field "type"`,
+		`filter.all[1]: unknown field "unknown"`,
+		`filter.any[0]: invalid value "question" for field "type"`,
+	}
+	if len(validationErr.Errors) != len(expected) {
+		t.Fatalf("expected %d errors, but got %v", len(expected), validationErr.Errors)
+	}
+	for i, e := range expected {
+		if validationErr.Errors[i] != e {
+			t.Fatalf("expected error %q, but got %q", e, validationErr.Errors[i])
+		}
+	}
+}
+
+func TestCreateSLAPolicyWithFilter(t *testing.T) {
+	definitionRequests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/slas/policies/definitions.json":
+			definitionRequests++
+			w.Write(readFixture(filepath.Join(http.MethodGet, "sla_policy_definitions.json")))
+		case r.Method == http.MethodPost && r.URL.Path == "/slas/policies.json":
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture(filepath.Join(http.MethodPost, "sla_policies.json")))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	invalid := SLAPolicy{Title: "Incidents"}
+	invalid.Filter.All = []SLAPolicyFilter{{Field: "type", Operator: "is", Value: "bug"}}
+
+	// an invalid policy is rejected without being sent
+	_, err := client.CreateSLAPolicy(ctx, invalid)
+	if _, ok := err.(*SLAPolicyValidationError); !ok {
+		t.Fatalf("expected an SLAPolicyValidationError, but got %v", err)
+	}
+	_, err = client.UpdateSLAPolicy(ctx, 123, invalid)
+	if _, ok := err.(*SLAPolicyValidationError); !ok {
+		t.Fatalf("expected an SLAPolicyValidationError, but got %v", err)
+	}
+
+	valid := SLAPolicy{Title: "Incidents"}
+	valid.Filter.All = []SLAPolicyFilter{{Field: "type", Operator: "is", Value: "incident"}}
+
+	_, err = client.CreateSLAPolicy(ctx, valid)
+	if err != nil {
+		t.Fatalf("Failed to create sla policy: %s", err)
+	}
+
+	if definitionRequests != 1 {
+		t.Fatalf("expected definitions to be fetched once, but got %d requests", definitionRequests)
+	}
+}
+
+func TestCreateSLAPolicyWithFilterDefinitionsFailure(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/slas/policies/definitions.json":
+			w.WriteHeader(http.StatusInternalServerError)
+		case r.Method == http.MethodPost && r.URL.Path == "/slas/policies.json":
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture(filepath.Join(http.MethodPost, "sla_policies.json")))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	policy := SLAPolicy{Title: "Incidents"}
+	policy.Filter.All = []SLAPolicyFilter{{Field: "type", Operator: "is", Value: "incident"}}
+
+	// the policy is still sent when the definitions cannot be fetched
+	_, err := client.CreateSLAPolicy(ctx, policy)
+	if err != nil {
+		t.Fatalf("Failed to create sla policy: %s", err)
+	}
+}
+
+func TestValidateSLAPolicy(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sla_policy_definitions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	policy := SLAPolicy{Title: "Incidents"}
+	policy.Filter.All = []SLAPolicyFilter{{Field: "type", Operator: "is", Value: "bug"}}
+
+	err := client.ValidateSLAPolicy(ctx, policy)
+	if _, ok := err.(*SLAPolicyValidationError); !ok {
+		t.Fatalf("expected an SLAPolicyValidationError, but got %v", err)
+	}
+}
@@ -0,0 +1,506 @@
+package zendesk
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Statuses of SLAMetricPrediction
+const (
+	SLAMetricStatusActive    = "active"
+	SLAMetricStatusPaused    = "paused"
+	SLAMetricStatusBreached  = "breached"
+	SLAMetricStatusFulfilled = "fulfilled"
+)
+
+// Order of ticket statuses and priorities used by less_than and greater_than operators
+var (
+	slaStatusOrder   = []string{"new", "open", "pending", "hold", "solved", "closed"}
+	slaPriorityOrder = []string{"low", "normal", "high", "urgent"}
+)
+
+// Ticket statuses in which each pausable metric is running
+var slaRunningStatuses = map[string]map[string]bool{
+	AgentWorkTimeMetric:      {"new": true, "open": true},
+	RequesterWaitTimeMetric:  {"new": true, "open": true, "hold": true},
+	PausableUpdateTimeMetric: {"new": true, "open": true, "hold": true},
+}
+
+// Matches returns true if the ticket satisfies the filter.
+// It returns an error for fields and operators which cannot be evaluated locally.
+func (f SLAPolicyFilter) Matches(ticket Ticket) (bool, error) {
+	switch {
+	case f.Field == "status":
+		return matchSLAOrdered(f, slaStatusOrder, ticket.Status)
+	case f.Field == "priority":
+		return matchSLAOrdered(f, slaPriorityOrder, ticket.Priority)
+	case f.Field == "current_tags":
+		return matchSLATags(f, ticket.Tags)
+	}
+
+	value, ok := slaFilterValue(f.Field, ticket)
+	if !ok {
+		return false, fmt.Errorf("unsupported SLA policy filter field: %s", f.Field)
+	}
+
+	switch f.Operator {
+	case "is":
+		return value == f.Value, nil
+	case "is_not":
+		return value != f.Value, nil
+	}
+	return false, fmt.Errorf("unsupported SLA policy filter operator for %s: %s", f.Field, f.Operator)
+}
+
+// Matches returns true if the ticket satisfies all the conditions of Filter.All
+// and at least one of Filter.Any
+func (p SLAPolicy) Matches(ticket Ticket) (bool, error) {
+	for _, f := range p.Filter.All {
+		ok, err := f.Matches(ticket)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	if len(p.Filter.Any) == 0 {
+		return true, nil
+	}
+	for _, f := range p.Filter.Any {
+		ok, err := f.Matches(ticket)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func matchSLAOrdered(f SLAPolicyFilter, order []string, value string) (bool, error) {
+	switch f.Operator {
+	case "is":
+		return value == f.Value, nil
+	case "is_not":
+		return value != f.Value, nil
+	}
+
+	index := func(s string) int {
+		for i, o := range order {
+			if o == s {
+				return i
+			}
+		}
+		return -1
+	}
+	actual, expected := index(value), index(f.Value)
+	if expected < 0 {
+		return false, fmt.Errorf("invalid SLA policy filter value for %s: %s", f.Field, f.Value)
+	}
+	if actual < 0 {
+		return false, nil
+	}
+
+	switch f.Operator {
+	case "less_than":
+		return actual < expected, nil
+	case "less_than_equal":
+		return actual <= expected, nil
+	case "greater_than":
+		return actual > expected, nil
+	case "greater_than_equal":
+		return actual >= expected, nil
+	}
+	return false, fmt.Errorf("unsupported SLA policy filter operator for %s: %s", f.Field, f.Operator)
+}
+
+func matchSLATags(f SLAPolicyFilter, tags []string) (bool, error) {
+	has := map[string]bool{}
+	for _, tag := range tags {
+		has[tag] = true
+	}
+
+	included := false
+	for _, tag := range strings.Fields(f.Value) {
+		if has[tag] {
+			included = true
+			break
+		}
+	}
+
+	switch f.Operator {
+	case "includes":
+		return included, nil
+	case "not_includes":
+		return !included, nil
+	}
+	return false, fmt.Errorf("unsupported SLA policy filter operator for %s: %s", f.Field, f.Operator)
+}
+
+// slaFilterValue returns the value of the ticket compared by is and is_not operators
+func slaFilterValue(field string, ticket Ticket) (string, bool) {
+	formatID := func(id int64) string {
+		if id == 0 {
+			return ""
+		}
+		return strconv.FormatInt(id, 10)
+	}
+
+	switch field {
+	case "type":
+		return ticket.Type, true
+	case "group_id":
+		return ticket.GroupID.String(), true
+	case "assignee_id":
+		return formatID(ticket.AssigneeID), true
+	case "requester_id":
+		return formatID(ticket.RequesterID), true
+	case "organization_id":
+		return formatID(ticket.OrganizationID), true
+	case "brand_id":
+		return formatID(ticket.BrandID), true
+	case "ticket_form_id":
+		return formatID(ticket.TicketFormID), true
+	}
+
+	if strings.HasPrefix(field, "custom_fields_") {
+		id := strings.TrimPrefix(field, "custom_fields_")
+		for _, cf := range ticket.CustomFields {
+			if strconv.FormatInt(cf.ID, 10) != id {
+				continue
+			}
+			switch v := cf.Value.(type) {
+			case nil:
+				return "", true
+			case []string:
+				return strings.Join(v, " "), true
+			default:
+				return fmt.Sprint(v), true
+			}
+		}
+		return "", true
+	}
+	return "", false
+}
+
+// SLAMetricPrediction is the locally computed state of a metric of an SLA policy
+type SLAMetricPrediction struct {
+	Metric        string
+	Target        int
+	BusinessHours bool
+	Status        string
+	StartedAt     time.Time
+
+	// DueAt is when the target is breached. It is nil while the metric is paused.
+	DueAt *time.Time
+
+	// FulfilledAt is when the metric stopped, e.g. when an agent replied or the ticket was solved
+	FulfilledAt *time.Time
+
+	// Elapsed is the time counted toward the target
+	Elapsed time.Duration
+}
+
+// SLAPrediction is the policy applied to a ticket and the state of its metrics
+type SLAPrediction struct {
+	Policy  SLAPolicy
+	Metrics []SLAMetricPrediction
+}
+
+// NextDueAt returns the earliest due time of the active metrics
+func (p SLAPrediction) NextDueAt() (time.Time, bool) {
+	var next time.Time
+	found := false
+	for _, m := range p.Metrics {
+		if m.Status != SLAMetricStatusActive || m.DueAt == nil {
+			continue
+		}
+		if !found || m.DueAt.Before(next) {
+			next, found = *m.DueAt, true
+		}
+	}
+	return next, found
+}
+
+// SLAPredictor predicts SLA due times of tickets locally
+// from SLA policies, ticket audits and business hours.
+//
+// Comments by the requester are counted as end-user comments
+// and every other public comment as an agent reply.
+type SLAPredictor struct {
+	policies []SLAPolicy
+	hours    *BusinessHours
+}
+
+// NewSLAPredictor creates a predictor. hours may be nil if no metric is measured in business hours.
+func NewSLAPredictor(policies []SLAPolicy, hours *BusinessHours) *SLAPredictor {
+	sorted := make([]SLAPolicy, len(policies))
+	copy(sorted, policies)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	return &SLAPredictor{
+		policies: sorted,
+		hours:    hours,
+	}
+}
+
+// MatchPolicy returns the first policy in order of Position which matches the ticket
+func (p *SLAPredictor) MatchPolicy(ticket Ticket) (SLAPolicy, bool, error) {
+	for _, policy := range p.policies {
+		ok, err := policy.Matches(ticket)
+		if err != nil {
+			return SLAPolicy{}, false, fmt.Errorf("SLA policy %d: %w", policy.ID, err)
+		}
+		if ok {
+			return policy, true, nil
+		}
+	}
+	return SLAPolicy{}, false, nil
+}
+
+// Predict computes the state of the SLA metrics of the ticket at now.
+// ok is false if no policy applies to the ticket.
+func (p *SLAPredictor) Predict(ticket Ticket, audits []TicketAudit, now time.Time) (prediction SLAPrediction, ok bool, err error) {
+	policy, ok, err := p.MatchPolicy(ticket)
+	if err != nil || !ok {
+		return SLAPrediction{}, ok, err
+	}
+
+	timeline := NewTicketTimeline(audits)
+	if len(timeline.Entries) == 0 {
+		return SLAPrediction{}, false, errors.New("ticket has no audits")
+	}
+	history := newSLAHistory(ticket, timeline, now)
+
+	prediction.Policy = policy
+	for _, metric := range policy.PolicyMetrics {
+		if metric.Priority != ticket.Priority {
+			continue
+		}
+		if metric.BusinessHours && p.hours == nil {
+			return SLAPrediction{}, false, fmt.Errorf("business hours are required for %s", metric.Metric)
+		}
+
+		m, ok := p.predictMetric(metric, history, now)
+		if ok {
+			prediction.Metrics = append(prediction.Metrics, m)
+		}
+	}
+	return prediction, true, nil
+}
+
+// slaStatusSegment is a period the ticket stayed in a status
+type slaStatusSegment struct {
+	status string
+	start  time.Time
+	end    time.Time
+}
+
+// slaHistory is the part of the ticket history SLA metrics depend on
+type slaHistory struct {
+	createdAt         time.Time
+	solvedAt          *time.Time
+	segments          []slaStatusSegment
+	agentReplies      []time.Time
+	requesterComments []time.Time
+}
+
+func newSLAHistory(ticket Ticket, timeline *TicketTimeline, now time.Time) slaHistory {
+	h := slaHistory{createdAt: timeline.Entries[0].Snapshot.At}
+
+	for i, entry := range timeline.Entries {
+		end := now
+		if i+1 < len(timeline.Entries) {
+			end = timeline.Entries[i+1].Snapshot.At
+		}
+		status := entry.Snapshot.Status
+		if n := len(h.segments); n > 0 && h.segments[n-1].status == status {
+			h.segments[n-1].end = end
+		} else {
+			h.segments = append(h.segments, slaStatusSegment{status: status, start: entry.Snapshot.At, end: end})
+		}
+
+		// the comment of the first audit is the description of the ticket
+		if i == 0 {
+			continue
+		}
+		for _, event := range entry.Audit.Events {
+			public, authorID := false, int64(0)
+			switch e := event.(type) {
+			case AuditCommentEvent:
+				public, authorID = e.Public, e.AuthorID
+			case AuditVoiceCommentEvent:
+				public, authorID = e.Public, e.AuthorID
+			default:
+				continue
+			}
+			if !public {
+				continue
+			}
+			if authorID == 0 {
+				authorID = entry.Audit.AuthorID
+			}
+
+			if authorID == ticket.RequesterID {
+				h.requesterComments = append(h.requesterComments, entry.Snapshot.At)
+			} else {
+				h.agentReplies = append(h.agentReplies, entry.Snapshot.At)
+			}
+		}
+	}
+
+	if last := h.segments[len(h.segments)-1]; last.status == "solved" || last.status == "closed" {
+		h.solvedAt = &last.start
+	}
+	return h
+}
+
+func (p *SLAPredictor) predictMetric(metric SLAPolicyMetric, h slaHistory, now time.Time) (SLAMetricPrediction, bool) {
+	switch metric.Metric {
+	case FirstReplyTimeMetric:
+		stop := h.solvedAt
+		if len(h.agentReplies) > 0 {
+			stop = &h.agentReplies[0]
+		}
+		return p.predictReplyMetric(metric, h.createdAt, stop, now), true
+
+	case NextReplyTimeMetric:
+		n := len(h.agentReplies)
+		if n == 0 {
+			return SLAMetricPrediction{}, false
+		}
+		// the oldest requester comment after the last reply is waiting for the next reply
+		if start, ok := firstTimeAfter(h.requesterComments, h.agentReplies[n-1]); ok {
+			return p.predictReplyMetric(metric, start, h.solvedAt, now), true
+		}
+		// otherwise the last reply answered the comments since the reply before it
+		if n >= 2 {
+			if start, ok := firstTimeAfter(h.requesterComments, h.agentReplies[n-2]); ok {
+				return p.predictReplyMetric(metric, start, &h.agentReplies[n-1], now), true
+			}
+		}
+		return SLAMetricPrediction{}, false
+
+	case PeriodicUpdateTimeMetric:
+		return p.predictReplyMetric(metric, h.lastUpdate(), h.solvedAt, now), true
+
+	case PausableUpdateTimeMetric:
+		return p.predictPausableMetric(metric, h.lastUpdate(), h, now), true
+
+	case AgentWorkTimeMetric, RequesterWaitTimeMetric:
+		return p.predictPausableMetric(metric, h.createdAt, h, now), true
+	}
+	return SLAMetricPrediction{}, false
+}
+
+// lastUpdate returns when the last agent reply was made, or when the ticket was created
+func (h slaHistory) lastUpdate() time.Time {
+	if n := len(h.agentReplies); n > 0 {
+		return h.agentReplies[n-1]
+	}
+	return h.createdAt
+}
+
+func firstTimeAfter(times []time.Time, after time.Time) (time.Time, bool) {
+	for _, t := range times {
+		if t.After(after) {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// predictReplyMetric predicts a metric which runs from start until stop without pausing
+func (p *SLAPredictor) predictReplyMetric(metric SLAPolicyMetric, start time.Time, stop *time.Time, now time.Time) SLAMetricPrediction {
+	target := time.Duration(metric.Target) * time.Minute
+	due := p.add(metric, start, target)
+	m := SLAMetricPrediction{
+		Metric:        metric.Metric,
+		Target:        metric.Target,
+		BusinessHours: metric.BusinessHours,
+		StartedAt:     start,
+		DueAt:         &due,
+		FulfilledAt:   stop,
+	}
+
+	end := now
+	if stop != nil {
+		end = *stop
+	}
+	m.Elapsed = p.elapsed(metric, start, end)
+
+	switch {
+	case m.Elapsed > target:
+		m.Status = SLAMetricStatusBreached
+	case stop != nil:
+		m.Status = SLAMetricStatusFulfilled
+	default:
+		m.Status = SLAMetricStatusActive
+	}
+	return m
+}
+
+// predictPausableMetric predicts a metric which only runs while the ticket is in specific statuses
+func (p *SLAPredictor) predictPausableMetric(metric SLAPolicyMetric, start time.Time, h slaHistory, now time.Time) SLAMetricPrediction {
+	target := time.Duration(metric.Target) * time.Minute
+	running := slaRunningStatuses[metric.Metric]
+	m := SLAMetricPrediction{
+		Metric:        metric.Metric,
+		Target:        metric.Target,
+		BusinessHours: metric.BusinessHours,
+		StartedAt:     start,
+		FulfilledAt:   h.solvedAt,
+	}
+
+	for _, s := range h.segments {
+		from := s.start
+		if from.Before(start) {
+			from = start
+		}
+		if !running[s.status] || !s.end.After(from) {
+			continue
+		}
+
+		d := p.elapsed(metric, from, s.end)
+		if m.DueAt == nil && m.Elapsed+d > target {
+			due := p.add(metric, from, target-m.Elapsed)
+			m.DueAt = &due
+		}
+		m.Elapsed += d
+	}
+
+	last := h.segments[len(h.segments)-1]
+	switch {
+	case m.Elapsed > target:
+		m.Status = SLAMetricStatusBreached
+	case h.solvedAt != nil:
+		m.Status = SLAMetricStatusFulfilled
+	case running[last.status]:
+		m.Status = SLAMetricStatusActive
+		due := p.add(metric, now, target-m.Elapsed)
+		m.DueAt = &due
+	default:
+		m.Status = SLAMetricStatusPaused
+	}
+	return m
+}
+
+func (p *SLAPredictor) elapsed(metric SLAPolicyMetric, from, to time.Time) time.Duration {
+	if metric.BusinessHours {
+		return p.hours.businessDuration(from, to)
+	}
+	return to.Sub(from)
+}
+
+func (p *SLAPredictor) add(metric SLAPolicyMetric, t time.Time, d time.Duration) time.Time {
+	if metric.BusinessHours {
+		return p.hours.addBusinessDuration(t, d)
+	}
+	return t.Add(d)
+}
@@ -0,0 +1,238 @@
+package zendesk
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestSLAPolicies() []SLAPolicy {
+	urgent := SLAPolicy{ID: 1, Title: "Normal and above", Position: 2}
+	urgent.Filter.All = []SLAPolicyFilter{{Field: "priority", Operator: "greater_than_equal", Value: "normal"}}
+	urgent.PolicyMetrics = []SLAPolicyMetric{
+		{Priority: "high", Metric: FirstReplyTimeMetric, Target: 30},
+	}
+
+	vip := SLAPolicy{ID: 2, Title: "VIP incidents", Position: 1}
+	vip.Filter.All = []SLAPolicyFilter{{Field: "current_tags", Operator: "includes", Value: "vip premium"}}
+	vip.Filter.Any = []SLAPolicyFilter{
+		{Field: "type", Operator: "is", Value: "incident"},
+		{Field: "type", Operator: "is", Value: "problem"},
+	}
+	vip.PolicyMetrics = []SLAPolicyMetric{
+		{Priority: "high", Metric: FirstReplyTimeMetric, Target: 120, BusinessHours: true},
+		{Priority: "high", Metric: NextReplyTimeMetric, Target: 60, BusinessHours: true},
+		{Priority: "high", Metric: AgentWorkTimeMetric, Target: 480, BusinessHours: true},
+		{Priority: "urgent", Metric: FirstReplyTimeMetric, Target: 10},
+	}
+
+	return []SLAPolicy{urgent, vip}
+}
+
+func newTestSLAAudit(id int64, at time.Time, authorID int64, events ...AuditEvent) TicketAudit {
+	return TicketAudit{ID: id, TicketID: 1, AuthorID: authorID, CreatedAt: &at, Events: events}
+}
+
+func newTestSLAComment(authorID int64) AuditCommentEvent {
+	return AuditCommentEvent{
+		AuditEventBase: AuditEventBase{Type: AuditEventTypeComment},
+		Public:         true,
+		AuthorID:       authorID,
+	}
+}
+
+func newTestSLAStatusChange(from, to string) AuditChangeEvent {
+	return AuditChangeEvent{
+		AuditEventBase: AuditEventBase{Type: AuditEventTypeChange},
+		FieldName:      "status",
+		Value:          NewAuditValue(to),
+		PreviousValue:  NewAuditValue(from),
+	}
+}
+
+func findSLAMetric(t *testing.T, prediction SLAPrediction, metric string) SLAMetricPrediction {
+	for _, m := range prediction.Metrics {
+		if m.Metric == metric {
+			return m
+		}
+	}
+	t.Fatalf("metric %s is not predicted", metric)
+	return SLAMetricPrediction{}
+}
+
+func TestSLAPolicyMatches(t *testing.T) {
+	ticket := Ticket{Priority: "high", Type: "task", Tags: []string{"vip"}, GroupID: "360000000001"}
+	policies := newTestSLAPolicies()
+
+	if ok, err := policies[0].Matches(ticket); err != nil || !ok {
+		t.Fatalf("expected policy to match: %v", err)
+	}
+	if ok, err := policies[1].Matches(ticket); err != nil || ok {
+		t.Fatalf("expected policy not to match: %v", err)
+	}
+
+	cases := []struct {
+		filter   SLAPolicyFilter
+		expected bool
+	}{
+		{SLAPolicyFilter{Field: "priority", Operator: "less_than", Value: "urgent"}, true},
+		{SLAPolicyFilter{Field: "priority", Operator: "greater_than", Value: "high"}, false},
+		{SLAPolicyFilter{Field: "current_tags", Operator: "not_includes", Value: "vip"}, false},
+		{SLAPolicyFilter{Field: "group_id", Operator: "is", Value: "360000000001"}, true},
+		{SLAPolicyFilter{Field: "assignee_id", Operator: "is", Value: ""}, true},
+	}
+	for _, c := range cases {
+		if ok, err := c.filter.Matches(ticket); err != nil || ok != c.expected {
+			t.Errorf("expected %v to be %v, but got %v: %v", c.filter, c.expected, ok, err)
+		}
+	}
+
+	if _, err := (SLAPolicyFilter{Field: "via_id", Operator: "is", Value: "4"}).Matches(ticket); err == nil {
+		t.Fatal("expected error for unsupported field")
+	}
+}
+
+func TestSLAPredictorMatchPolicyPosition(t *testing.T) {
+	predictor := NewSLAPredictor(newTestSLAPolicies(), nil)
+
+	policy, ok, err := predictor.MatchPolicy(Ticket{Priority: "high", Type: "incident", Tags: []string{"vip"}})
+	if err != nil || !ok {
+		t.Fatalf("expected a policy to match: %v", err)
+	}
+	if policy.ID != 2 {
+		t.Fatalf("expected policy 2 with the lowest position, but got %d", policy.ID)
+	}
+
+	if _, ok, _ := predictor.MatchPolicy(Ticket{Priority: "low"}); ok {
+		t.Fatal("expected no policy to match")
+	}
+}
+
+func TestSLAPredictorPredict(t *testing.T) {
+	hours, loc := newTestBusinessHours(t)
+	predictor := NewSLAPredictor(newTestSLAPolicies(), hours)
+
+	ticket := Ticket{ID: 1, RequesterID: 1, Priority: "high", Type: "incident", Status: "pending", Tags: []string{"vip"}}
+	audits := []TicketAudit{
+		// audits are sorted by the predictor
+		newTestSLAAudit(4, time.Date(2023, 12, 20, 16, 30, 0, 0, loc), 2, newTestSLAStatusChange("open", "pending")),
+		newTestSLAAudit(1, time.Date(2023, 12, 20, 10, 0, 0, 0, loc), 1,
+			AuditCreateEvent{AuditEventBase: AuditEventBase{Type: AuditEventTypeCreate}, FieldName: "status", Value: NewAuditValue("new")},
+			newTestSLAComment(1),
+		),
+		newTestSLAAudit(2, time.Date(2023, 12, 20, 11, 0, 0, 0, loc), 2, newTestSLAStatusChange("new", "open"), newTestSLAComment(2)),
+		newTestSLAAudit(3, time.Date(2023, 12, 20, 16, 0, 0, 0, loc), 1, newTestSLAComment(1)),
+	}
+	now := time.Date(2023, 12, 21, 10, 0, 0, 0, loc)
+
+	prediction, ok, err := predictor.Predict(ticket, audits, now)
+	if err != nil || !ok {
+		t.Fatalf("Failed to predict SLA: %v", err)
+	}
+	if prediction.Policy.ID != 2 {
+		t.Fatalf("expected policy 2, but got %d", prediction.Policy.ID)
+	}
+	if len(prediction.Metrics) != 3 {
+		t.Fatalf("expected 3 metrics of high priority, but got %d", len(prediction.Metrics))
+	}
+
+	frt := findSLAMetric(t, prediction, FirstReplyTimeMetric)
+	if frt.Status != SLAMetricStatusFulfilled || !frt.FulfilledAt.Equal(time.Date(2023, 12, 20, 11, 0, 0, 0, loc)) {
+		t.Fatalf("expected first reply to be fulfilled at 11:00, but got %s at %v", frt.Status, frt.FulfilledAt)
+	}
+
+	nrt := findSLAMetric(t, prediction, NextReplyTimeMetric)
+	if nrt.Status != SLAMetricStatusBreached || !nrt.DueAt.Equal(time.Date(2023, 12, 20, 17, 0, 0, 0, loc)) {
+		t.Fatalf("expected next reply to be breached at 17:00, but got %s at %v", nrt.Status, nrt.DueAt)
+	}
+	if nrt.Elapsed != 120*time.Minute {
+		t.Fatalf("expected 120 business minutes for next reply, but got %s", nrt.Elapsed)
+	}
+
+	awt := findSLAMetric(t, prediction, AgentWorkTimeMetric)
+	if awt.Status != SLAMetricStatusPaused || awt.DueAt != nil {
+		t.Fatalf("expected agent work time to be paused, but got %s", awt.Status)
+	}
+	if awt.Elapsed != 390*time.Minute {
+		t.Fatalf("expected 390 business minutes of agent work, but got %s", awt.Elapsed)
+	}
+
+	if _, ok := prediction.NextDueAt(); ok {
+		t.Fatal("expected no active metric")
+	}
+}
+
+func TestSLAPredictorPredictActive(t *testing.T) {
+	hours, loc := newTestBusinessHours(t)
+	predictor := NewSLAPredictor(newTestSLAPolicies(), hours)
+
+	ticket := Ticket{ID: 1, RequesterID: 1, Priority: "high", Type: "incident", Status: "open", Tags: []string{"vip"}}
+	audits := []TicketAudit{
+		newTestSLAAudit(1, time.Date(2023, 12, 20, 10, 0, 0, 0, loc), 1,
+			AuditCreateEvent{AuditEventBase: AuditEventBase{Type: AuditEventTypeCreate}, FieldName: "status", Value: NewAuditValue("open")},
+			newTestSLAComment(1),
+		),
+	}
+	now := time.Date(2023, 12, 20, 17, 0, 0, 0, loc)
+
+	prediction, ok, err := predictor.Predict(ticket, audits, now)
+	if err != nil || !ok {
+		t.Fatalf("Failed to predict SLA: %v", err)
+	}
+
+	frt := findSLAMetric(t, prediction, FirstReplyTimeMetric)
+	if frt.Status != SLAMetricStatusBreached {
+		t.Fatalf("expected first reply to be breached, but got %s", frt.Status)
+	}
+
+	awt := findSLAMetric(t, prediction, AgentWorkTimeMetric)
+	if awt.Status != SLAMetricStatusActive || !awt.DueAt.Equal(time.Date(2023, 12, 21, 10, 0, 0, 0, loc)) {
+		t.Fatalf("expected agent work time to be due on Thursday 10:00, but got %s at %v", awt.Status, awt.DueAt)
+	}
+
+	// next reply time has not started without an agent reply
+	for _, m := range prediction.Metrics {
+		if m.Metric == NextReplyTimeMetric {
+			t.Fatal("expected next reply time not to be predicted")
+		}
+	}
+
+	next, ok := prediction.NextDueAt()
+	if !ok || !next.Equal(*awt.DueAt) {
+		t.Fatalf("expected next due time to be %v, but got %v", awt.DueAt, next)
+	}
+}
+
+func TestSLAPredictorPredictSolved(t *testing.T) {
+	hours, loc := newTestBusinessHours(t)
+	predictor := NewSLAPredictor(newTestSLAPolicies(), hours)
+
+	ticket := Ticket{ID: 1, RequesterID: 1, Priority: "high", Type: "problem", Status: "solved", Tags: []string{"premium"}}
+	solvedAt := time.Date(2023, 12, 20, 11, 0, 0, 0, loc)
+	audits := []TicketAudit{
+		newTestSLAAudit(1, time.Date(2023, 12, 20, 10, 0, 0, 0, loc), 1,
+			AuditCreateEvent{AuditEventBase: AuditEventBase{Type: AuditEventTypeCreate}, FieldName: "status", Value: NewAuditValue("open")},
+		),
+		newTestSLAAudit(2, solvedAt, 2, newTestSLAStatusChange("open", "solved"), newTestSLAComment(2)),
+	}
+
+	prediction, _, err := predictor.Predict(ticket, audits, solvedAt.Add(48*time.Hour))
+	if err != nil {
+		t.Fatalf("Failed to predict SLA: %v", err)
+	}
+	for _, m := range prediction.Metrics {
+		if m.Status != SLAMetricStatusFulfilled {
+			t.Fatalf("expected %s to be fulfilled, but got %s", m.Metric, m.Status)
+		}
+	}
+}
+
+func TestSLAPredictorRequiresBusinessHours(t *testing.T) {
+	predictor := NewSLAPredictor(newTestSLAPolicies(), nil)
+
+	now := time.Now()
+	ticket := Ticket{RequesterID: 1, Priority: "high", Type: "incident", Tags: []string{"vip"}}
+	audits := []TicketAudit{newTestSLAAudit(1, now, 1)}
+	if _, _, err := predictor.Predict(ticket, audits, now); err == nil {
+		t.Fatal("expected error without business hours")
+	}
+}
@@ -0,0 +1,222 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SuspendedTicketBatchLimit is the maximum number of IDs accepted by
+// recover_many and destroy_many at once
+const SuspendedTicketBatchLimit = 100
+
+// SuspendedTicketAuthor is the author of a suspended ticket
+type SuspendedTicketAuthor struct {
+	ID    int64  `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// SuspendedTicket is struct for suspended ticket payload
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/
+type SuspendedTicket struct {
+	ID            int64                 `json:"id,omitempty"`
+	URL           string                `json:"url,omitempty"`
+	Author        SuspendedTicketAuthor `json:"author,omitempty"`
+	BrandID       int64                 `json:"brand_id,omitempty"`
+	Cause         string                `json:"cause,omitempty"`
+	CauseID       int64                 `json:"cause_id,omitempty"`
+	Content       string                `json:"content,omitempty"`
+	MessageID     string                `json:"message_id,omitempty"`
+	Recipient     string                `json:"recipient,omitempty"`
+	Subject       string                `json:"subject,omitempty"`
+	TicketID      int64                 `json:"ticket_id,omitempty"`
+	Attachments   []Attachment          `json:"attachments,omitempty"`
+	ErrorMessages []string              `json:"error_messages,omitempty"`
+	Via           *Via                  `json:"via,omitempty"`
+	CreatedAt     *time.Time            `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time            `json:"updated_at,omitempty"`
+}
+
+// SuspendedTicketListOptions is options for GetSuspendedTickets
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/#list-suspended-tickets
+type SuspendedTicketListOptions struct {
+	PageOptions
+
+	// SortBy can take "author_email", "cause", "created_at", "subject"
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// SuspendedTicketAPI an interface containing all suspended ticket related methods
+type SuspendedTicketAPI interface {
+	GetSuspendedTickets(ctx context.Context, opts *SuspendedTicketListOptions) ([]SuspendedTicket, Page, error)
+	GetSuspendedTicket(ctx context.Context, id int64) (SuspendedTicket, error)
+	RecoverSuspendedTicket(ctx context.Context, id int64) (Ticket, error)
+	RecoverSuspendedTickets(ctx context.Context, ids []int64) ([]Ticket, error)
+	DeleteSuspendedTicket(ctx context.Context, id int64) error
+	DeleteSuspendedTickets(ctx context.Context, ids []int64) error
+	ExportSuspendedTicketAttachments(ctx context.Context, id int64) (Upload, error)
+	GetSuspendedTicketsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SuspendedTicket]
+	GetSuspendedTicketsOBP(ctx context.Context, opts *OBPOptions) ([]SuspendedTicket, Page, error)
+	GetSuspendedTicketsCBP(ctx context.Context, opts *CBPOptions) ([]SuspendedTicket, CursorPaginationMeta, error)
+}
+
+// FilterSuspendedTicketsByCause returns the suspended tickets suspended for
+// one of the given causes, e.g. "Detected as spam".
+// The list endpoint doesn't support filtering so it is done on the client.
+func FilterSuspendedTicketsByCause(tickets []SuspendedTicket, causes ...string) []SuspendedTicket {
+	var filtered []SuspendedTicket
+	for _, ticket := range tickets {
+		for _, cause := range causes {
+			if ticket.Cause == cause {
+				filtered = append(filtered, ticket)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// GetSuspendedTickets fetch suspended ticket list
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/#list-suspended-tickets
+func (z *Client) GetSuspendedTickets(ctx context.Context, opts *SuspendedTicketListOptions) ([]SuspendedTicket, Page, error) {
+	var data struct {
+		SuspendedTickets []SuspendedTicket `json:"suspended_tickets"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &SuspendedTicketListOptions{}
+	}
+
+	u, err := addOptions("/suspended_tickets.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.SuspendedTickets, data.Page, nil
+}
+
+// GetSuspendedTicket gets a specified suspended ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/#show-suspended-ticket
+func (z *Client) GetSuspendedTicket(ctx context.Context, id int64) (SuspendedTicket, error) {
+	var result struct {
+		SuspendedTicket SuspendedTicket `json:"suspended_ticket"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/suspended_tickets/%d.json", id))
+	if err != nil {
+		return SuspendedTicket{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SuspendedTicket{}, err
+	}
+	return result.SuspendedTicket, nil
+}
+
+// RecoverSuspendedTicket recovers a suspended ticket and returns the created ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/#recover-suspended-ticket
+func (z *Client) RecoverSuspendedTicket(ctx context.Context, id int64) (Ticket, error) {
+	var result struct {
+		Ticket []Ticket `json:"ticket"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/suspended_tickets/%d/recover.json", id), nil)
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Ticket{}, err
+	}
+	if len(result.Ticket) == 0 {
+		return Ticket{}, fmt.Errorf("suspended ticket %d was not recovered", id)
+	}
+	return result.Ticket[0], nil
+}
+
+// RecoverSuspendedTickets recovers multiple suspended tickets.
+// IDs are sent in chunks of SuspendedTicketBatchLimit.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/#recover-multiple-suspended-tickets
+func (z *Client) RecoverSuspendedTickets(ctx context.Context, ids []int64) ([]Ticket, error) {
+	var tickets []Ticket
+	for _, c := range chunk(ids, SuspendedTicketBatchLimit) {
+		var result struct {
+			Tickets []Ticket `json:"tickets"`
+		}
+
+		u := fmt.Sprintf("/suspended_tickets/recover_many.json?ids=%s", joinIDs(c))
+		body, err := z.put(ctx, u, nil)
+		if err != nil {
+			return tickets, err
+		}
+
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			return tickets, err
+		}
+		tickets = append(tickets, result.Tickets...)
+	}
+	return tickets, nil
+}
+
+// DeleteSuspendedTicket deletes a specified suspended ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/#delete-suspended-ticket
+func (z *Client) DeleteSuspendedTicket(ctx context.Context, id int64) error {
+	return z.delete(ctx, fmt.Sprintf("/suspended_tickets/%d.json", id))
+}
+
+// DeleteSuspendedTickets deletes multiple suspended tickets.
+// IDs are sent in chunks of SuspendedTicketBatchLimit.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/#delete-multiple-suspended-tickets
+func (z *Client) DeleteSuspendedTickets(ctx context.Context, ids []int64) error {
+	for _, c := range chunk(ids, SuspendedTicketBatchLimit) {
+		err := z.delete(ctx, fmt.Sprintf("/suspended_tickets/destroy_many.json?ids=%s", joinIDs(c)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportSuspendedTicketAttachments makes copies of the attachments of a
+// suspended ticket and returns them as an upload token which can be
+// used when recovering the ticket manually
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/#suspended-ticket-attachments
+func (z *Client) ExportSuspendedTicketAttachments(ctx context.Context, id int64) (Upload, error) {
+	var result struct {
+		Upload Upload `json:"upload"`
+	}
+
+	body, err := z.post(ctx, fmt.Sprintf("/suspended_tickets/%d/attachments.json", id), nil)
+	if err != nil {
+		return Upload{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Upload{}, err
+	}
+	return result.Upload, nil
+}
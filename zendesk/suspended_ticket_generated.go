@@ -0,0 +1,74 @@
+
+// Code generated by Script. DO NOT EDIT.
+// Source: script/codegen/main.go
+//
+// Generated by this command:
+//
+//	go run script/codegen/main.go
+
+package zendesk
+
+import "context"
+
+func (z *Client) GetSuspendedTicketsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SuspendedTicket] {
+	return &Iterator[SuspendedTicket]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         opts.IsCBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc:       z.GetSuspendedTicketsOBP,
+		cbpFunc:       z.GetSuspendedTicketsCBP,
+	}
+}
+
+func (z *Client) GetSuspendedTicketsOBP(ctx context.Context, opts *OBPOptions) ([]SuspendedTicket, Page, error) {
+	var data struct {
+		SuspendedTickets []SuspendedTicket `json:"suspended_tickets"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OBPOptions{}
+	}
+	
+	u, err := addOptions("/suspended_tickets.json", tmp)
+	
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.SuspendedTickets, data.Page, nil
+}
+
+func (z *Client) GetSuspendedTicketsCBP(ctx context.Context, opts *CBPOptions) ([]SuspendedTicket, CursorPaginationMeta, error) {
+	var data struct {
+		SuspendedTickets []SuspendedTicket `json:"suspended_tickets"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+	
+	u, err := addOptions("/suspended_tickets.json", tmp)
+	
+	if err != nil {
+		return nil, data.Meta, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	return data.SuspendedTickets, data.Meta, nil
+}
+
@@ -0,0 +1,163 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGetSuspendedTickets(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "suspended_tickets.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, _, err := client.GetSuspendedTickets(ctx, &SuspendedTicketListOptions{SortBy: "cause"})
+	if err != nil {
+		t.Fatalf("Failed to get suspended tickets: %s", err)
+	}
+
+	if len(tickets) != 2 {
+		t.Fatalf("expected length of suspended tickets is %d, but got %d", 2, len(tickets))
+	}
+
+	spam := FilterSuspendedTicketsByCause(tickets, "Detected as spam")
+	if len(spam) != 1 || spam[0].ID != 3436 {
+		t.Fatalf("unexpected filtered suspended tickets: %v", spam)
+	}
+}
+
+func TestGetSuspendedTicketsIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "suspended_tickets.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.GetSuspendedTicketsIterator(ctx, NewPaginationOptions())
+
+	count := 0
+	for it.HasMore() {
+		tickets, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get suspended tickets: %s", err)
+		}
+		count += len(tickets)
+	}
+	if count != 2 {
+		t.Fatalf("expected length of suspended tickets is %d, but got %d", 2, count)
+	}
+}
+
+func TestGetSuspendedTicket(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "suspended_ticket.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := client.GetSuspendedTicket(ctx, 3436)
+	if err != nil {
+		t.Fatalf("Failed to get suspended ticket: %s", err)
+	}
+
+	if ticket.Author.Email != "styx@example.com" {
+		t.Fatalf("expected author email %s, but got %s", "styx@example.com", ticket.Author.Email)
+	}
+}
+
+func TestRecoverSuspendedTicket(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "recover_suspended_ticket.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := client.RecoverSuspendedTicket(ctx, 3436)
+	if err != nil {
+		t.Fatalf("Failed to recover suspended ticket: %s", err)
+	}
+
+	if ticket.ID != 3436 {
+		t.Fatalf("expected ticket id %d, but got %d", 3436, ticket.ID)
+	}
+}
+
+func TestRecoverSuspendedTicketsInChunks(t *testing.T) {
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if n := len(strings.Split(r.URL.Query().Get("ids"), ",")); n > SuspendedTicketBatchLimit {
+			t.Fatalf("expected at most %d ids, but got %d", SuspendedTicketBatchLimit, n)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "recover_suspended_tickets.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 150)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	tickets, err := client.RecoverSuspendedTickets(ctx, ids)
+	if err != nil {
+		t.Fatalf("Failed to recover suspended tickets: %s", err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("expected %d requests, but got %d", 2, requests)
+	}
+	if len(tickets) != 4 {
+		t.Fatalf("expected length of tickets is %d, but got %d", 4, len(tickets))
+	}
+}
+
+func TestDeleteSuspendedTicket(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteSuspendedTicket(ctx, 3436)
+	if err != nil {
+		t.Fatalf("Failed to delete suspended ticket: %s", err)
+	}
+}
+
+func TestDeleteSuspendedTicketsInChunks(t *testing.T) {
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if !strings.HasSuffix(r.URL.Path, "/suspended_tickets/destroy_many.json") {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 201)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	err := client.DeleteSuspendedTickets(ctx, ids)
+	if err != nil {
+		t.Fatalf("Failed to delete suspended tickets: %s", err)
+	}
+	if requests != 3 {
+		t.Fatalf("expected %d requests, but got %d", 3, requests)
+	}
+}
+
+func TestExportSuspendedTicketAttachments(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "suspended_ticket_attachments.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	upload, err := client.ExportSuspendedTicketAttachments(ctx, 3436)
+	if err != nil {
+		t.Fatalf("Failed to export suspended ticket attachments: %s", err)
+	}
+
+	if upload.Token != "yrznqgjoa24iw2f" || len(upload.Attachments) != 1 {
+		t.Fatalf("unexpected upload: %v", upload)
+	}
+}
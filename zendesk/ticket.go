@@ -9,6 +9,9 @@ import (
 	"time"
 )
 
+// TicketBatchLimit is the maximum number of IDs accepted by the ticket bulk endpoints at once
+const TicketBatchLimit = 100
+
 type CustomField struct {
 	ID int64 `json:"id"`
 	// Valid types are string or []string.
@@ -76,11 +79,7 @@ type Ticket struct {
 
 	Via *Via `json:"via,omitempty"`
 
-	SatisfactionRating *struct {
-		ID      int64  `json:"id"`
-		Score   string `json:"score"`
-		Comment string `json:"comment"`
-	} `json:"satisfaction_rating,omitempty"`
+	SatisfactionRating *SatisfactionRating `json:"satisfaction_rating,omitempty"`
 
 	SharingAgreementIDs []int64    `json:"sharing_agreement_ids,omitempty"`
 	FollowupIDs         []int64    `json:"followup_ids,omitempty"`
@@ -97,6 +96,10 @@ type Ticket struct {
 	// Collaborators is POST only
 	Collaborators *Collaborators `json:"collaborators,omitempty"`
 
+	// EmailCCs and Followers are POST/PUT only and add or remove users
+	EmailCCs  []TicketParticipantUpdate `json:"email_ccs,omitempty"`
+	Followers []TicketParticipantUpdate `json:"followers,omitempty"`
+
 	// Comment is POST only and required
 	Comment *TicketComment `json:"comment,omitempty"`
 
@@ -165,6 +168,8 @@ type TicketAPI interface {
 	CreateTicket(ctx context.Context, ticket Ticket) (Ticket, error)
 	UpdateTicket(ctx context.Context, ticketID int64, ticket Ticket) (Ticket, error)
 	DeleteTicket(ctx context.Context, ticketID int64) error
+	MarkTicketAsSpam(ctx context.Context, ticketID int64) error
+	MarkTicketsAsSpam(ctx context.Context, ticketIDs []int64) ([]JobStatus, error)
 }
 
 // GetTickets get ticket list with offset based pagination
@@ -336,3 +341,21 @@ func (z *Client) DeleteTicket(ctx context.Context, ticketID int64) error {
 
 	return nil
 }
+
+// MarkTicketAsSpam marks the ticket as spam and suspends the requester
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#mark-ticket-as-spam-and-suspend-requester
+func (z *Client) MarkTicketAsSpam(ctx context.Context, ticketID int64) error {
+	_, err := z.put(ctx, fmt.Sprintf("/tickets/%d/mark_as_spam.json", ticketID), nil)
+	return err
+}
+
+// MarkTicketsAsSpam marks multiple tickets as spam and suspends their requesters.
+// IDs are sent in chunks of TicketBatchLimit and a job status is returned for each chunk.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#bulk-mark-tickets-as-spam
+func (z *Client) MarkTicketsAsSpam(ctx context.Context, ticketIDs []int64) ([]JobStatus, error) {
+	return chunkJobs(ticketIDs, TicketBatchLimit, func(ids []int64) (JobStatus, error) {
+		return z.putJobStatus(ctx, fmt.Sprintf("/tickets/mark_many_as_spam.json?ids=%s", joinIDs(ids)), nil)
+	})
+}
@@ -15,7 +15,7 @@ type TicketAudit struct {
 	Via       TicketAuditVia `json:"via,omitempty"`
 	CreatedAt *time.Time     `json:"created_at,omitempty"`
 	AuthorID  int64          `json:"author_id,omitempty"`
-	Events    []interface{}  `json:"events,omitempty"`
+	Events    AuditEvents    `json:"events,omitempty"`
 }
 
 // TicketAuditVia is struct for via payload
@@ -25,6 +25,7 @@ type TicketAuditVia struct {
 		To   interface{} `json:"to,omitempty"`
 		From interface{} `json:"from,omitempty"`
 		Ref  string      `json:"ref,omitempty"`
+		Rel  string      `json:"rel,omitempty"`
 	} `json:"source,omitempty"`
 }
 
@@ -0,0 +1,483 @@
+package zendesk
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Ticket audit event types
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_audits/#audit-events
+const (
+	AuditEventTypeCreate               = "Create"
+	AuditEventTypeChange               = "Change"
+	AuditEventTypeComment              = "Comment"
+	AuditEventTypeVoiceComment         = "VoiceComment"
+	AuditEventTypeCommentPrivacyChange = "CommentPrivacyChange"
+	AuditEventTypeNotification         = "Notification"
+	AuditEventTypeCC                   = "Cc"
+	AuditEventTypeFollowerChange       = "FollowerChange"
+	AuditEventTypeEmailCCChange        = "EmailCcChange"
+	AuditEventTypeError                = "Error"
+	AuditEventTypeExternal             = "External"
+	AuditEventTypePush                 = "Push"
+	AuditEventTypeSatisfactionRating   = "SatisfactionRating"
+	AuditEventTypeTweet                = "Tweet"
+	AuditEventTypeSMS                  = "SMS"
+	AuditEventTypeTicketSharing        = "TicketSharingEvent"
+	AuditEventTypeOrganizationActivity = "OrganizationActivity"
+	AuditEventTypeAgentMacroReference  = "AgentMacroReference"
+)
+
+// AuditEvent is an event contained in a TicketAudit.
+// Use a type switch or FilterAuditEvents to access the concrete event.
+type AuditEvent interface {
+	EventID() int64
+	EventType() string
+}
+
+// AuditEventBase holds the fields common to every audit event
+type AuditEventBase struct {
+	ID   int64  `json:"id"`
+	Type string `json:"type"`
+}
+
+// EventID returns the ID of the event
+func (e AuditEventBase) EventID() int64 {
+	return e.ID
+}
+
+// EventType returns the type of the event, e.g. "Change"
+func (e AuditEventBase) EventType() string {
+	return e.Type
+}
+
+// AuditValue is the value of Create and Change events.
+// Zendesk sends a string, an array of strings (e.g. tags) or null
+// depending on the field.
+type AuditValue struct {
+	value  string
+	list   []string
+	isList bool
+	isNull bool
+}
+
+// NewAuditValue returns an AuditValue holding a single string
+func NewAuditValue(s string) AuditValue {
+	return AuditValue{value: s}
+}
+
+// NewAuditListValue returns an AuditValue holding an array of strings
+func NewAuditListValue(list []string) AuditValue {
+	return AuditValue{list: list, isList: true}
+}
+
+// String returns the value as string. Array values are joined with a space
+// in the same way Zendesk represents tags.
+func (v AuditValue) String() string {
+	if v.isList {
+		return strings.Join(v.list, " ")
+	}
+	return v.value
+}
+
+// Strings returns the value as an array of strings.
+// A single string value is returned as an array with one element.
+func (v AuditValue) Strings() []string {
+	if v.isList {
+		return v.list
+	}
+	if v.isNull || v.value == "" {
+		return nil
+	}
+	return []string{v.value}
+}
+
+// Int64 returns the value as int64. ok is false when the value is not a number.
+func (v AuditValue) Int64() (n int64, ok bool) {
+	if v.isList || v.isNull {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(v.value, 10, 64)
+	return n, err == nil
+}
+
+// IsNull reports whether the value was null
+func (v AuditValue) IsNull() bool {
+	return v.isNull
+}
+
+// IsList reports whether the value was an array
+func (v AuditValue) IsList() bool {
+	return v.isList
+}
+
+// UnmarshalJSON is unmarshaller for AuditValue
+func (v *AuditValue) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+
+	*v = AuditValue{}
+	switch e := raw.(type) {
+	case nil:
+		v.isNull = true
+	case []interface{}:
+		v.isList = true
+		v.list = make([]string, 0, len(e))
+		for _, item := range e {
+			v.list = append(v.list, auditScalarString(item))
+		}
+	default:
+		v.value = auditScalarString(e)
+	}
+	return nil
+}
+
+// MarshalJSON is marshaller for AuditValue
+func (v AuditValue) MarshalJSON() ([]byte, error) {
+	switch {
+	case v.isNull:
+		return []byte("null"), nil
+	case v.isList:
+		if v.list == nil {
+			return []byte("[]"), nil
+		}
+		return json.Marshal(v.list)
+	default:
+		return json.Marshal(v.value)
+	}
+}
+
+func auditScalarString(i interface{}) string {
+	switch e := i.(type) {
+	case nil:
+		return ""
+	case string:
+		return e
+	case json.Number:
+		return e.String()
+	case bool:
+		return strconv.FormatBool(e)
+	default:
+		b, _ := json.Marshal(e)
+		return string(b)
+	}
+}
+
+// AuditCreateEvent records the value of a ticket field when the ticket is created
+type AuditCreateEvent struct {
+	AuditEventBase
+	FieldName string     `json:"field_name"`
+	Value     AuditValue `json:"value"`
+}
+
+// AuditChangeEvent records a change of a ticket field
+type AuditChangeEvent struct {
+	AuditEventBase
+	FieldName     string          `json:"field_name"`
+	Value         AuditValue      `json:"value"`
+	PreviousValue AuditValue      `json:"previous_value"`
+	Via           *TicketAuditVia `json:"via,omitempty"`
+}
+
+// AuditCommentEvent records a comment added to the ticket
+type AuditCommentEvent struct {
+	AuditEventBase
+	Body        string                 `json:"body"`
+	HTMLBody    string                 `json:"html_body,omitempty"`
+	PlainBody   string                 `json:"plain_body,omitempty"`
+	Public      bool                   `json:"public"`
+	AuthorID    int64                  `json:"author_id,omitempty"`
+	AuditID     int64                  `json:"audit_id,omitempty"`
+	Attachments []Attachment           `json:"attachments,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Via         *TicketAuditVia        `json:"via,omitempty"`
+}
+
+// AuditVoiceCommentData is details of the call recorded by AuditVoiceCommentEvent
+type AuditVoiceCommentData struct {
+	From              string     `json:"from,omitempty"`
+	To                string     `json:"to,omitempty"`
+	RecordingURL      string     `json:"recording_url,omitempty"`
+	StartedAt         *time.Time `json:"started_at,omitempty"`
+	CallDuration      int64      `json:"call_duration,omitempty"`
+	AnsweredByID      int64      `json:"answered_by_id,omitempty"`
+	TranscriptionText string     `json:"transcription_text,omitempty"`
+	Location          string     `json:"location,omitempty"`
+}
+
+// AuditVoiceCommentEvent records a voicemail or a call added as a comment
+type AuditVoiceCommentEvent struct {
+	AuditEventBase
+	Data                 AuditVoiceCommentData `json:"data"`
+	FormattedFrom        string                `json:"formatted_from,omitempty"`
+	FormattedTo          string                `json:"formatted_to,omitempty"`
+	Body                 string                `json:"body,omitempty"`
+	HTMLBody             string                `json:"html_body,omitempty"`
+	Public               bool                  `json:"public"`
+	Trusted              bool                  `json:"trusted"`
+	AuthorID             int64                 `json:"author_id,omitempty"`
+	TranscriptionVisible bool                  `json:"transcription_visible"`
+	Attachments          []Attachment          `json:"attachments,omitempty"`
+}
+
+// AuditCommentPrivacyChangeEvent records a comment being made private or public
+type AuditCommentPrivacyChangeEvent struct {
+	AuditEventBase
+	CommentID int64 `json:"comment_id"`
+	Public    bool  `json:"public"`
+}
+
+// AuditNotificationEvent records an email notification sent by a business rule
+type AuditNotificationEvent struct {
+	AuditEventBase
+	Subject    string          `json:"subject"`
+	Body       string          `json:"body"`
+	Recipients []int64         `json:"recipients"`
+	Via        *TicketAuditVia `json:"via,omitempty"`
+}
+
+// AuditCCEvent records a CC notification sent by a business rule
+type AuditCCEvent struct {
+	AuditEventBase
+	Recipients []int64         `json:"recipients"`
+	Via        *TicketAuditVia `json:"via,omitempty"`
+}
+
+// AuditFollowerChangeEvent records a change of the ticket followers
+type AuditFollowerChangeEvent struct {
+	AuditEventBase
+	CurrentFollowers  []string `json:"current_followers"`
+	PreviousFollowers []string `json:"previous_followers"`
+}
+
+// AuditEmailCCChangeEvent records a change of the ticket email CCs
+type AuditEmailCCChangeEvent struct {
+	AuditEventBase
+	CurrentEmailCCs  []string `json:"current_email_ccs"`
+	PreviousEmailCCs []string `json:"previous_email_ccs"`
+}
+
+// AuditErrorEvent records an error while processing the ticket update
+type AuditErrorEvent struct {
+	AuditEventBase
+	Message string `json:"message"`
+}
+
+// AuditExternalEvent records a notification sent to a target
+type AuditExternalEvent struct {
+	AuditEventBase
+	Resource json.Number `json:"resource"`
+	Body     string      `json:"body"`
+	Success  interface{} `json:"success"`
+}
+
+// AuditPushEvent records information pushed to the ticket by an app
+type AuditPushEvent struct {
+	AuditEventBase
+	Value          string `json:"value"`
+	ValueReference string `json:"value_reference"`
+}
+
+// AuditSatisfactionRatingEvent records a satisfaction rating given to the ticket
+type AuditSatisfactionRatingEvent struct {
+	AuditEventBase
+	Score      string `json:"score"`
+	AssigneeID int64  `json:"assignee_id"`
+	Body       string `json:"body"`
+}
+
+// AuditTweetEvent records a tweet sent by a business rule
+type AuditTweetEvent struct {
+	AuditEventBase
+	DirectMessage bool    `json:"direct_message"`
+	Body          string  `json:"body"`
+	Recipients    []int64 `json:"recipients"`
+}
+
+// AuditSMSEvent records an SMS sent by a business rule
+type AuditSMSEvent struct {
+	AuditEventBase
+	RecipientID int64  `json:"recipient_id"`
+	PhoneNumber string `json:"phone_number"`
+	Body        string `json:"body"`
+}
+
+// AuditTicketSharingEvent records the ticket being shared or unshared
+// through a sharing agreement
+type AuditTicketSharingEvent struct {
+	AuditEventBase
+	AgreementID int64  `json:"agreement_id"`
+	Action      string `json:"action"`
+}
+
+// AuditOrganizationActivityEvent records a notification sent to
+// organization subscribers
+type AuditOrganizationActivityEvent struct {
+	AuditEventBase
+	Recipients []int64 `json:"recipients"`
+	Subject    string  `json:"subject"`
+	Body       string  `json:"body"`
+}
+
+// AuditAgentMacroReferenceEvent records a macro applied by an agent
+type AuditAgentMacroReferenceEvent struct {
+	AuditEventBase
+	MacroID      json.Number `json:"macro_id"`
+	MacroTitle   string      `json:"macro_title"`
+	MacroDeleted bool        `json:"macro_deleted"`
+}
+
+// AuditUnknownEvent is an event which could not be decoded into one of
+// the typed events. Raw holds the original JSON payload.
+type AuditUnknownEvent struct {
+	AuditEventBase
+	Raw json.RawMessage `json:"-"`
+}
+
+// MarshalJSON returns the original JSON payload of the event
+func (e AuditUnknownEvent) MarshalJSON() ([]byte, error) {
+	if e.Raw == nil {
+		return json.Marshal(e.AuditEventBase)
+	}
+	return e.Raw, nil
+}
+
+// AuditEvents is a list of typed ticket audit events
+type AuditEvents []AuditEvent
+
+// UnmarshalJSON decodes each event into its typed struct.
+// Events of an unsupported type, or which don't match the expected shape,
+// are decoded into AuditUnknownEvent so that no data is lost.
+func (e *AuditEvents) UnmarshalJSON(data []byte) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return err
+	}
+
+	events := make(AuditEvents, 0, len(raws))
+	for _, raw := range raws {
+		events = append(events, decodeAuditEvent(raw))
+	}
+	*e = events
+	return nil
+}
+
+func decodeAuditEvent(raw json.RawMessage) AuditEvent {
+	var base AuditEventBase
+	unknown := AuditUnknownEvent{Raw: raw}
+	if err := json.Unmarshal(raw, &base); err != nil {
+		return unknown
+	}
+	unknown.AuditEventBase = base
+
+	var event AuditEvent
+	var err error
+	switch base.Type {
+	case AuditEventTypeCreate:
+		event, err = decodeTypedAuditEvent[AuditCreateEvent](raw)
+	case AuditEventTypeChange:
+		event, err = decodeTypedAuditEvent[AuditChangeEvent](raw)
+	case AuditEventTypeComment:
+		event, err = decodeTypedAuditEvent[AuditCommentEvent](raw)
+	case AuditEventTypeVoiceComment:
+		event, err = decodeTypedAuditEvent[AuditVoiceCommentEvent](raw)
+	case AuditEventTypeCommentPrivacyChange:
+		event, err = decodeTypedAuditEvent[AuditCommentPrivacyChangeEvent](raw)
+	case AuditEventTypeNotification:
+		event, err = decodeTypedAuditEvent[AuditNotificationEvent](raw)
+	case AuditEventTypeCC:
+		event, err = decodeTypedAuditEvent[AuditCCEvent](raw)
+	case AuditEventTypeFollowerChange:
+		event, err = decodeTypedAuditEvent[AuditFollowerChangeEvent](raw)
+	case AuditEventTypeEmailCCChange:
+		event, err = decodeTypedAuditEvent[AuditEmailCCChangeEvent](raw)
+	case AuditEventTypeError:
+		event, err = decodeTypedAuditEvent[AuditErrorEvent](raw)
+	case AuditEventTypeExternal:
+		event, err = decodeTypedAuditEvent[AuditExternalEvent](raw)
+	case AuditEventTypePush:
+		event, err = decodeTypedAuditEvent[AuditPushEvent](raw)
+	case AuditEventTypeSatisfactionRating:
+		event, err = decodeTypedAuditEvent[AuditSatisfactionRatingEvent](raw)
+	case AuditEventTypeTweet:
+		event, err = decodeTypedAuditEvent[AuditTweetEvent](raw)
+	case AuditEventTypeSMS:
+		event, err = decodeTypedAuditEvent[AuditSMSEvent](raw)
+	case AuditEventTypeTicketSharing:
+		event, err = decodeTypedAuditEvent[AuditTicketSharingEvent](raw)
+	case AuditEventTypeOrganizationActivity:
+		event, err = decodeTypedAuditEvent[AuditOrganizationActivityEvent](raw)
+	case AuditEventTypeAgentMacroReference:
+		event, err = decodeTypedAuditEvent[AuditAgentMacroReferenceEvent](raw)
+	default:
+		return unknown
+	}
+
+	if err != nil {
+		return unknown
+	}
+	return event
+}
+
+func decodeTypedAuditEvent[T AuditEvent](raw json.RawMessage) (AuditEvent, error) {
+	var event T
+	if err := json.Unmarshal(raw, &event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FilterAuditEvents returns the events of the concrete type T,
+// e.g. FilterAuditEvents[AuditCommentEvent](audit.Events)
+func FilterAuditEvents[T AuditEvent](events []AuditEvent) []T {
+	var filtered []T
+	for _, e := range events {
+		if typed, ok := e.(T); ok {
+			filtered = append(filtered, typed)
+		}
+	}
+	return filtered
+}
+
+// OfType returns the events whose type matches one of eventTypes
+func (e AuditEvents) OfType(eventTypes ...string) AuditEvents {
+	var filtered AuditEvents
+	for _, event := range e {
+		for _, t := range eventTypes {
+			if event.EventType() == t {
+				filtered = append(filtered, event)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// Changes returns all Change events
+func (e AuditEvents) Changes() []AuditChangeEvent {
+	return FilterAuditEvents[AuditChangeEvent](e)
+}
+
+// FieldChanges returns the Change events of the given field, e.g. "status"
+func (e AuditEvents) FieldChanges(fieldName string) []AuditChangeEvent {
+	var filtered []AuditChangeEvent
+	for _, c := range e.Changes() {
+		if c.FieldName == fieldName {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// Comments returns all Comment events
+func (e AuditEvents) Comments() []AuditCommentEvent {
+	return FilterAuditEvents[AuditCommentEvent](e)
+}
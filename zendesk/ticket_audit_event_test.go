@@ -0,0 +1,136 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTicketAuditEventsAreTyped(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_audit_events.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	audit, err := client.GetTicketAudit(ctx, 666, 2127301143)
+	if err != nil {
+		t.Fatalf("Failed to get ticket audit: %s", err)
+	}
+
+	if len(audit.Events) != 8 {
+		t.Fatalf("expected length of events is %d, but got %d", 8, len(audit.Events))
+	}
+
+	create, ok := audit.Events[0].(AuditCreateEvent)
+	if !ok {
+		t.Fatalf("expected AuditCreateEvent, but got %T", audit.Events[0])
+	}
+	if !create.Value.IsList() || !reflect.DeepEqual(create.Value.Strings(), []string{"billing", "vip"}) {
+		t.Fatalf("unexpected create value: %v", create.Value.Strings())
+	}
+
+	comment, ok := audit.Events[1].(AuditCommentEvent)
+	if !ok {
+		t.Fatalf("expected AuditCommentEvent, but got %T", audit.Events[1])
+	}
+	if comment.Public || comment.Body != "This is a new private comment" {
+		t.Fatalf("unexpected comment: %v", comment)
+	}
+
+	change, ok := audit.Events[2].(AuditChangeEvent)
+	if !ok {
+		t.Fatalf("expected AuditChangeEvent, but got %T", audit.Events[2])
+	}
+	if change.FieldName != "status" || change.Value.String() != "open" || change.PreviousValue.String() != "new" {
+		t.Fatalf("unexpected change: %v", change)
+	}
+	if change.Via == nil || change.Via.Source.Rel != "trigger" {
+		t.Fatalf("expected via rel to be trigger")
+	}
+
+	assignee, ok := audit.Events[3].(AuditChangeEvent)
+	if !ok {
+		t.Fatalf("expected AuditChangeEvent, but got %T", audit.Events[3])
+	}
+	if id, ok := assignee.Value.Int64(); !ok || id != 5246746 {
+		t.Fatalf("unexpected assignee value: %s", assignee.Value)
+	}
+	if !assignee.PreviousValue.IsNull() {
+		t.Fatalf("expected previous value to be null")
+	}
+
+	if _, ok := audit.Events[4].(AuditNotificationEvent); !ok {
+		t.Fatalf("expected AuditNotificationEvent, but got %T", audit.Events[4])
+	}
+	if rating, ok := audit.Events[5].(AuditSatisfactionRatingEvent); !ok || rating.Score != "good" {
+		t.Fatalf("unexpected satisfaction rating event: %v", audit.Events[5])
+	}
+	if sharing, ok := audit.Events[6].(AuditTicketSharingEvent); !ok || sharing.AgreementID != 3 {
+		t.Fatalf("unexpected ticket sharing event: %v", audit.Events[6])
+	}
+
+	unknown, ok := audit.Events[7].(AuditUnknownEvent)
+	if !ok {
+		t.Fatalf("expected AuditUnknownEvent, but got %T", audit.Events[7])
+	}
+	if unknown.EventType() != "ChatStartedEvent" || unknown.EventID() != 2127301168 {
+		t.Fatalf("unexpected unknown event: %v", unknown)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(unknown.Raw, &raw); err != nil {
+		t.Fatalf("Failed to unmarshal raw event: %s", err)
+	}
+	if _, ok := raw["value"]; !ok {
+		t.Fatalf("expected raw event to preserve value")
+	}
+}
+
+func TestTicketAuditEventsFilter(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_audit_events.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	audit, err := client.GetTicketAudit(ctx, 666, 2127301143)
+	if err != nil {
+		t.Fatalf("Failed to get ticket audit: %s", err)
+	}
+
+	if n := len(audit.Events.Changes()); n != 2 {
+		t.Fatalf("expected %d changes, but got %d", 2, n)
+	}
+	if n := len(audit.Events.FieldChanges("status")); n != 1 {
+		t.Fatalf("expected %d status changes, but got %d", 1, n)
+	}
+	if n := len(audit.Events.Comments()); n != 1 {
+		t.Fatalf("expected %d comments, but got %d", 1, n)
+	}
+	if n := len(audit.Events.OfType(AuditEventTypeNotification, AuditEventTypeSatisfactionRating)); n != 2 {
+		t.Fatalf("expected %d events, but got %d", 2, n)
+	}
+	if n := len(FilterAuditEvents[AuditUnknownEvent](audit.Events)); n != 1 {
+		t.Fatalf("expected %d unknown events, but got %d", 1, n)
+	}
+}
+
+func TestTicketAuditEventsRemarshal(t *testing.T) {
+	in := `[{"id":1,"type":"Change","field_name":"tags","value":["a","b"],"previous_value":null},` +
+		`{"id":2,"type":"Mystery","foo":"bar"}]`
+
+	var events AuditEvents
+	if err := json.Unmarshal([]byte(in), &events); err != nil {
+		t.Fatalf("Failed to unmarshal events: %s", err)
+	}
+
+	out, err := json.Marshal(events)
+	if err != nil {
+		t.Fatalf("Failed to marshal events: %s", err)
+	}
+
+	var expected, actual interface{}
+	json.Unmarshal([]byte(in), &expected)
+	json.Unmarshal(out, &actual)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %s, but got %s", in, out)
+	}
+}
@@ -0,0 +1,79 @@
+
+// Code generated by Script. DO NOT EDIT.
+// Source: script/codegen/main.go
+//
+// Generated by this command:
+//
+//	go run script/codegen/main.go
+
+package zendesk
+
+import (
+	"context"
+	"fmt"
+)
+
+func (z *Client) GetTicketIncidentsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket] {
+	return &Iterator[Ticket]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         opts.IsCBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc:       z.GetTicketIncidentsOBP,
+		cbpFunc:       z.GetTicketIncidentsCBP,
+	}
+}
+
+func (z *Client) GetTicketIncidentsOBP(ctx context.Context, opts *OBPOptions) ([]Ticket, Page, error) {
+	var data struct {
+		Tickets []Ticket `json:"tickets"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OBPOptions{}
+	}
+	
+	path := fmt.Sprintf("/tickets/%d/incidents.json", tmp.Id)
+	u, err := addOptions(path, tmp)
+	
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Tickets, data.Page, nil
+}
+
+func (z *Client) GetTicketIncidentsCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, CursorPaginationMeta, error) {
+	var data struct {
+		Tickets []Ticket `json:"tickets"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+	
+	path := fmt.Sprintf("/tickets/%d/incidents.json", tmp.Id)
+	u, err := addOptions(path, tmp)
+	
+	if err != nil {
+		return nil, data.Meta, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	return data.Tickets, data.Meta, nil
+}
+
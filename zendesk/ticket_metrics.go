@@ -7,12 +7,28 @@ import (
 	"time"
 )
 
-// TimeDuration represents a time in business or calendar days
+// TimeDuration represents a time in business or calendar minutes
 type TimeDuration struct {
 	Business int `json:"business"`
 	Calendar int `json:"calendar"`
 }
 
+// BusinessDuration returns the business hours part as time.Duration
+func (d TimeDuration) BusinessDuration() time.Duration {
+	return time.Duration(d.Business) * time.Minute
+}
+
+// CalendarDuration returns the calendar hours part as time.Duration
+func (d TimeDuration) CalendarDuration() time.Duration {
+	return time.Duration(d.Calendar) * time.Minute
+}
+
+// OutsideBusinessHours returns the part of the calendar duration
+// which elapsed outside of business hours
+func (d TimeDuration) OutsideBusinessHours() time.Duration {
+	return d.CalendarDuration() - d.BusinessDuration()
+}
+
 type TicketMetric struct {
 	AgentWaitTimeInMinutes       TimeDuration `json:"agent_wait_time_in_minutes"`
 	AssignedAt                   time.Time    `json:"assigned_at"`
@@ -52,9 +68,12 @@ type TicketMetricListOptions struct {
 // TicketMetricsAPI is an interface containing all methods for the ticket
 // metrics API
 type TicketMetricsAPI interface {
-	GetTicketMetrics(ctx context.Context, opts ...TicketMetricListOptions) ([]TicketMetric, Page, error)
+	GetTicketMetrics(ctx context.Context, opts *TicketMetricListOptions) ([]TicketMetric, Page, error)
 	GetTicketMetric(ctx context.Context, ticketMetricsID int64) (TicketMetric, error)
 	GetTicketMetricByTicket(ctx context.Context, ticketID int64) (TicketMetric, error)
+	GetTicketMetricsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketMetric]
+	GetTicketMetricsOBP(ctx context.Context, opts *OBPOptions) ([]TicketMetric, Page, error)
+	GetTicketMetricsCBP(ctx context.Context, opts *CBPOptions) ([]TicketMetric, CursorPaginationMeta, error)
 }
 
 // GetTicketMetrics get ticket metrics list with offset based pagination
@@ -89,6 +108,8 @@ func (z *Client) GetTicketMetrics(ctx context.Context, opts *TicketMetricListOpt
 
 }
 
+// GetTicketMetric gets a specified ticket metric
+//
 // ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_metrics/#show-ticket-metrics
 func (z *Client) GetTicketMetric(ctx context.Context, ticketMetricsID int64) (TicketMetric, error) {
 	var result struct {
@@ -108,6 +129,8 @@ func (z *Client) GetTicketMetric(ctx context.Context, ticketMetricsID int64) (Ti
 	return result.TicketMetric, err
 }
 
+// GetTicketMetricByTicket gets the ticket metric of a specified ticket
+//
 // ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_metrics/#show-ticket-metrics
 func (z *Client) GetTicketMetricByTicket(ctx context.Context, ticketID int64) (TicketMetric, error) {
 	var result struct {
@@ -126,3 +149,8 @@ func (z *Client) GetTicketMetricByTicket(ctx context.Context, ticketID int64) (T
 
 	return result.TicketMetric, err
 }
+
+// ReplyTime returns the calendar time to the first reply with second precision
+func (m TicketMetric) ReplyTime() time.Duration {
+	return time.Duration(m.ReplyTimeInSeconds.Calendar) * time.Second
+}
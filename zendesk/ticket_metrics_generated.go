@@ -0,0 +1,74 @@
+
+// Code generated by Script. DO NOT EDIT.
+// Source: script/codegen/main.go
+//
+// Generated by this command:
+//
+//	go run script/codegen/main.go
+
+package zendesk
+
+import "context"
+
+func (z *Client) GetTicketMetricsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketMetric] {
+	return &Iterator[TicketMetric]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         opts.IsCBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc:       z.GetTicketMetricsOBP,
+		cbpFunc:       z.GetTicketMetricsCBP,
+	}
+}
+
+func (z *Client) GetTicketMetricsOBP(ctx context.Context, opts *OBPOptions) ([]TicketMetric, Page, error) {
+	var data struct {
+		TicketMetrics []TicketMetric `json:"ticket_metrics"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OBPOptions{}
+	}
+	
+	u, err := addOptions("/ticket_metrics.json", tmp)
+	
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.TicketMetrics, data.Page, nil
+}
+
+func (z *Client) GetTicketMetricsCBP(ctx context.Context, opts *CBPOptions) ([]TicketMetric, CursorPaginationMeta, error) {
+	var data struct {
+		TicketMetrics []TicketMetric `json:"ticket_metrics"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+	
+	u, err := addOptions("/ticket_metrics.json", tmp)
+	
+	if err != nil {
+		return nil, data.Meta, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	return data.TicketMetrics, data.Meta, nil
+}
+
@@ -0,0 +1,86 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetTicketMetrics(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_metrics.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticketMetrics, _, err := client.GetTicketMetrics(ctx, &TicketMetricListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get ticket metrics: %s", err)
+	}
+
+	expectedLength := 2
+	if len(ticketMetrics) != expectedLength {
+		t.Fatalf("Returned ticket metrics does not have the expected length %d. Ticket metrics length is %d", expectedLength, len(ticketMetrics))
+	}
+}
+
+func TestGetTicketMetricsIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_metrics.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ops := NewPaginationOptions()
+	it := client.GetTicketMetricsIterator(ctx, ops)
+
+	expectedLength := 2
+	ticketMetricCount := 0
+	for it.HasMore() {
+		ticketMetrics, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get ticket metrics: %s", err)
+		}
+		ticketMetricCount += len(ticketMetrics)
+	}
+	if ticketMetricCount != expectedLength {
+		t.Fatalf("Returned ticket metrics does not have the expected length %d. Ticket metrics length is %d", expectedLength, ticketMetricCount)
+	}
+}
+
+func TestGetTicketMetric(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_metric.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticketMetric, err := client.GetTicketMetric(ctx, 33)
+	if err != nil {
+		t.Fatalf("Failed to get ticket metric: %s", err)
+	}
+
+	expectedID := 33
+	if ticketMetric.ID != expectedID {
+		t.Fatalf("Returned ticket metric does not have the expected ID %d. Ticket metric id is %d", expectedID, ticketMetric.ID)
+	}
+}
+
+func TestTicketMetricDurations(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_metric.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticketMetric, err := client.GetTicketMetricByTicket(ctx, 4343)
+	if err != nil {
+		t.Fatalf("Failed to get ticket metric: %s", err)
+	}
+
+	reply := ticketMetric.ReplyTimeInMinutes
+	if d := reply.BusinessDuration(); d != 737*time.Minute {
+		t.Fatalf("expected business duration %s, but got %s", 737*time.Minute, d)
+	}
+	if d := reply.CalendarDuration(); d != 2391*time.Minute {
+		t.Fatalf("expected calendar duration %s, but got %s", 2391*time.Minute, d)
+	}
+	if d := reply.OutsideBusinessHours(); d != 1654*time.Minute {
+		t.Fatalf("expected duration outside business hours %s, but got %s", 1654*time.Minute, d)
+	}
+	if d := ticketMetric.ReplyTime(); d != 143460*time.Second {
+		t.Fatalf("expected reply time %s, but got %s", 143460*time.Second, d)
+	}
+}
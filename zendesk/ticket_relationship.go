@@ -0,0 +1,230 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Actions of TicketParticipantUpdate
+const (
+	TicketParticipantActionPut    = "put"
+	TicketParticipantActionDelete = "delete"
+)
+
+// TicketParticipantUpdate adds or removes an email CC or a follower of a ticket.
+// The user is identified either by UserID or by UserEmail.
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-managing-requests/#setting-email-ccs-and-followers
+type TicketParticipantUpdate struct {
+	UserID    int64  `json:"user_id,omitempty"`
+	UserEmail string `json:"user_email,omitempty"`
+	UserName  string `json:"user_name,omitempty"`
+	Action    string `json:"action,omitempty"`
+}
+
+// AddTicketParticipant returns an update which adds the user to the email CCs or followers
+func AddTicketParticipant(userID int64) TicketParticipantUpdate {
+	return TicketParticipantUpdate{UserID: userID, Action: TicketParticipantActionPut}
+}
+
+// AddTicketParticipantByEmail returns an update which adds the user with
+// the email, creating the user if needed, to the email CCs or followers
+func AddTicketParticipantByEmail(email, name string) TicketParticipantUpdate {
+	return TicketParticipantUpdate{UserEmail: email, UserName: name, Action: TicketParticipantActionPut}
+}
+
+// RemoveTicketParticipant returns an update which removes the user from the email CCs or followers
+func RemoveTicketParticipant(userID int64) TicketParticipantUpdate {
+	return TicketParticipantUpdate{UserID: userID, Action: TicketParticipantActionDelete}
+}
+
+// TicketRelated contains ticket related data
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#ticket-related-information
+type TicketRelated struct {
+	TopicID           int64         `json:"topic_id,omitempty"`
+	JiraIssueIDs      []interface{} `json:"jira_issue_ids,omitempty"`
+	FollowupSourceIDs []int64       `json:"followup_source_ids,omitempty"`
+	FromArchive       bool          `json:"from_archive"`
+	Incidents         int64         `json:"incidents"`
+	Twitter           interface{}   `json:"twitter,omitempty"`
+}
+
+// TicketRelationshipAPI an interface containing methods for tickets related to a ticket
+type TicketRelationshipAPI interface {
+	GetTicketIncidents(ctx context.Context, ticketID int64, opts *PageOptions) ([]Ticket, Page, error)
+	GetProblems(ctx context.Context, opts *PageOptions) ([]Ticket, Page, error)
+	AutocompleteProblems(ctx context.Context, text string) ([]Ticket, error)
+	LinkIncidentToProblem(ctx context.Context, incidentID, problemID int64) (Ticket, error)
+	GetTicketCollaborators(ctx context.Context, ticketID int64) ([]User, error)
+	GetTicketFollowers(ctx context.Context, ticketID int64) ([]User, error)
+	GetTicketEmailCCs(ctx context.Context, ticketID int64) ([]User, error)
+	UpdateTicketFollowers(ctx context.Context, ticketID int64, updates ...TicketParticipantUpdate) (Ticket, error)
+	UpdateTicketEmailCCs(ctx context.Context, ticketID int64, updates ...TicketParticipantUpdate) (Ticket, error)
+	GetTicketRelated(ctx context.Context, ticketID int64) (TicketRelated, error)
+	GetTicketIncidentsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket]
+	GetTicketIncidentsOBP(ctx context.Context, opts *OBPOptions) ([]Ticket, Page, error)
+	GetTicketIncidentsCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, CursorPaginationMeta, error)
+	GetProblemsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket]
+	GetProblemsOBP(ctx context.Context, opts *OBPOptions) ([]Ticket, Page, error)
+	GetProblemsCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, CursorPaginationMeta, error)
+}
+
+// GetTicketIncidents fetch incident tickets linked to a problem ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-ticket-incidents
+func (z *Client) GetTicketIncidents(ctx context.Context, ticketID int64, opts *PageOptions) ([]Ticket, Page, error) {
+	var data struct {
+		Tickets []Ticket `json:"tickets"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/tickets/%d/incidents.json", ticketID), tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Tickets, data.Page, nil
+}
+
+// GetProblems fetch problem tickets
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-problems/#list-ticket-problems
+func (z *Client) GetProblems(ctx context.Context, opts *PageOptions) ([]Ticket, Page, error) {
+	var data struct {
+		Tickets []Ticket `json:"tickets"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions("/problems.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Tickets, data.Page, nil
+}
+
+// AutocompleteProblems returns problem tickets whose subject matches text
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-problems/#autocomplete-problems
+func (z *Client) AutocompleteProblems(ctx context.Context, text string) ([]Ticket, error) {
+	var data struct {
+		Text string `json:"text"`
+	}
+	var result struct {
+		Tickets []Ticket `json:"tickets"`
+	}
+	data.Text = text
+
+	body, err := z.post(ctx, "/problems/autocomplete.json", data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Tickets, nil
+}
+
+// LinkIncidentToProblem makes a ticket an incident of a problem ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#update-ticket
+func (z *Client) LinkIncidentToProblem(ctx context.Context, incidentID, problemID int64) (Ticket, error) {
+	return z.UpdateTicket(ctx, incidentID, Ticket{
+		Type:      "incident",
+		ProblemID: problemID,
+	})
+}
+
+// GetTicketCollaborators fetch collaborators of a ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-collaborators-for-a-ticket
+func (z *Client) GetTicketCollaborators(ctx context.Context, ticketID int64) ([]User, error) {
+	return z.getTicketUsers(ctx, fmt.Sprintf("/tickets/%d/collaborators.json", ticketID))
+}
+
+// GetTicketFollowers fetch followers of a ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-followers-for-a-ticket
+func (z *Client) GetTicketFollowers(ctx context.Context, ticketID int64) ([]User, error) {
+	return z.getTicketUsers(ctx, fmt.Sprintf("/tickets/%d/followers.json", ticketID))
+}
+
+// GetTicketEmailCCs fetch email CCs of a ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-email-ccs-for-a-ticket
+func (z *Client) GetTicketEmailCCs(ctx context.Context, ticketID int64) ([]User, error) {
+	return z.getTicketUsers(ctx, fmt.Sprintf("/tickets/%d/email_ccs.json", ticketID))
+}
+
+func (z *Client) getTicketUsers(ctx context.Context, path string) ([]User, error) {
+	var result struct {
+		Users []User `json:"users"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Users, nil
+}
+
+// UpdateTicketFollowers adds or removes followers of a ticket
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-managing-requests/#setting-email-ccs-and-followers
+func (z *Client) UpdateTicketFollowers(ctx context.Context, ticketID int64, updates ...TicketParticipantUpdate) (Ticket, error) {
+	return z.UpdateTicket(ctx, ticketID, Ticket{Followers: updates})
+}
+
+// UpdateTicketEmailCCs adds or removes email CCs of a ticket
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-managing-requests/#setting-email-ccs-and-followers
+func (z *Client) UpdateTicketEmailCCs(ctx context.Context, ticketID int64, updates ...TicketParticipantUpdate) (Ticket, error) {
+	return z.UpdateTicket(ctx, ticketID, Ticket{EmailCCs: updates})
+}
+
+// GetTicketRelated retrieves ticket related information
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#ticket-related-information
+func (z *Client) GetTicketRelated(ctx context.Context, ticketID int64) (TicketRelated, error) {
+	var result struct {
+		TicketRelated TicketRelated `json:"ticket_related"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/tickets/%d/related.json", ticketID))
+	if err != nil {
+		return TicketRelated{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TicketRelated{}, err
+	}
+	return result.TicketRelated, nil
+}
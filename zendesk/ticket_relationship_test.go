@@ -0,0 +1,213 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetTicketIncidents(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "tickets.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, _, err := client.GetTicketIncidents(ctx, 2, nil)
+	if err != nil {
+		t.Fatalf("Failed to get ticket incidents: %s", err)
+	}
+
+	if len(tickets) != 2 {
+		t.Fatalf("expected length of tickets is 2, but got %d", len(tickets))
+	}
+}
+
+func TestGetProblems(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "tickets.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, _, err := client.GetProblems(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get problems: %s", err)
+	}
+
+	if len(tickets) != 2 {
+		t.Fatalf("expected length of tickets is 2, but got %d", len(tickets))
+	}
+}
+
+func TestAutocompleteProblems(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Text string `json:"text"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		if data.Text != "login" {
+			t.Fatalf("unexpected text in request body: %s", body)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "problems_autocomplete.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, err := client.AutocompleteProblems(ctx, "login")
+	if err != nil {
+		t.Fatalf("Failed to autocomplete problems: %s", err)
+	}
+
+	if len(tickets) != 2 {
+		t.Fatalf("expected length of tickets is 2, but got %d", len(tickets))
+	}
+	if tickets[0].Type != "problem" {
+		t.Fatalf("expected type of ticket is problem, but got %s", tickets[0].Type)
+	}
+}
+
+func TestLinkIncidentToProblem(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Ticket Ticket `json:"ticket"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		if r.URL.Path != "/tickets/2.json" || data.Ticket.Type != "incident" || data.Ticket.ProblemID != 33 {
+			t.Fatalf("unexpected request %s: %s", r.URL.Path, body)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.LinkIncidentToProblem(ctx, 2, 33)
+	if err != nil {
+		t.Fatalf("Failed to link incident to problem: %s", err)
+	}
+}
+
+func TestGetTicketCollaborators(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "users.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, err := client.GetTicketCollaborators(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get ticket collaborators: %s", err)
+	}
+
+	if len(users) == 0 {
+		t.Fatal("expected collaborators, but got none")
+	}
+}
+
+func TestGetTicketFollowers(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "users.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, err := client.GetTicketFollowers(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get ticket followers: %s", err)
+	}
+
+	if len(users) == 0 {
+		t.Fatal("expected followers, but got none")
+	}
+}
+
+func TestGetTicketEmailCCs(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "users.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, err := client.GetTicketEmailCCs(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get ticket email CCs: %s", err)
+	}
+
+	if len(users) == 0 {
+		t.Fatal("expected email CCs, but got none")
+	}
+}
+
+func TestUpdateTicketEmailCCs(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Ticket map[string]json.RawMessage `json:"ticket"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+
+		expected := `[{"user_id":10,"action":"put"},{"user_email":"jdoe@example.com","user_name":"John Doe","action":"put"},{"user_id":11,"action":"delete"}]`
+		if len(data.Ticket) != 1 || string(data.Ticket["email_ccs"]) != expected {
+			t.Fatalf("unexpected request body: %s", body)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTicketEmailCCs(ctx, 2,
+		AddTicketParticipant(10),
+		AddTicketParticipantByEmail("jdoe@example.com", "John Doe"),
+		RemoveTicketParticipant(11),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update ticket email CCs: %s", err)
+	}
+}
+
+func TestUpdateTicketFollowers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Ticket Ticket `json:"ticket"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		if len(data.Ticket.Followers) != 1 || data.Ticket.Followers[0].Action != TicketParticipantActionDelete {
+			t.Fatalf("unexpected request body: %s", body)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTicketFollowers(ctx, 2, RemoveTicketParticipant(10))
+	if err != nil {
+		t.Fatalf("Failed to update ticket followers: %s", err)
+	}
+}
+
+func TestGetTicketRelated(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_related.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	related, err := client.GetTicketRelated(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get ticket related: %s", err)
+	}
+
+	if related.Incidents != 7 {
+		t.Fatalf("expected incidents is 7, but got %d", related.Incidents)
+	}
+}
@@ -0,0 +1,79 @@
+
+// Code generated by Script. DO NOT EDIT.
+// Source: script/codegen/main.go
+//
+// Generated by this command:
+//
+//	go run script/codegen/main.go
+
+package zendesk
+
+import (
+	"context"
+	"fmt"
+)
+
+func (z *Client) GetTicketSkipsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Skip] {
+	return &Iterator[Skip]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         opts.IsCBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc:       z.GetTicketSkipsOBP,
+		cbpFunc:       z.GetTicketSkipsCBP,
+	}
+}
+
+func (z *Client) GetTicketSkipsOBP(ctx context.Context, opts *OBPOptions) ([]Skip, Page, error) {
+	var data struct {
+		Skips []Skip `json:"skips"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OBPOptions{}
+	}
+	
+	path := fmt.Sprintf("/tickets/%d/skips.json", tmp.Id)
+	u, err := addOptions(path, tmp)
+	
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Skips, data.Page, nil
+}
+
+func (z *Client) GetTicketSkipsCBP(ctx context.Context, opts *CBPOptions) ([]Skip, CursorPaginationMeta, error) {
+	var data struct {
+		Skips []Skip `json:"skips"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+	
+	path := fmt.Sprintf("/tickets/%d/skips.json", tmp.Id)
+	u, err := addOptions(path, tmp)
+	
+	if err != nil {
+		return nil, data.Meta, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	return data.Skips, data.Meta, nil
+}
+
@@ -405,6 +405,48 @@ func TestDeleteTicket(t *testing.T) {
 	}
 }
 
+func TestMarkTicketAsSpam(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/tickets/437/mark_as_spam.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.MarkTicketAsSpam(ctx, 437)
+	if err != nil {
+		t.Fatalf("Failed to mark ticket as spam: %s", err)
+	}
+}
+
+func TestMarkTicketsAsSpam(t *testing.T) {
+	var queries []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query().Get("ids"))
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture("PUT/job_status.json"))
+	}))
+
+	ids := make([]int64, TicketBatchLimit+1)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	c := newTestClient(mockAPI)
+	jobStatuses, err := c.MarkTicketsAsSpam(ctx, ids)
+	if err != nil {
+		t.Fatalf("Failed to mark tickets as spam: %s", err)
+	}
+
+	if len(jobStatuses) != 2 || len(queries) != 2 {
+		t.Fatalf("expected 2 requests and job statuses, but got %d and %d", len(queries), len(jobStatuses))
+	}
+	if queries[1] != fmt.Sprint(TicketBatchLimit+1) {
+		t.Fatalf("unexpected ids of the last chunk: %s", queries[1])
+	}
+}
+
 func TestTicketMarshalling(t *testing.T) {
 	var src, dst Ticket
 
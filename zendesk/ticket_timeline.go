@@ -0,0 +1,214 @@
+package zendesk
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+// TicketSnapshot is the state of the ticket fields right after an audit
+type TicketSnapshot struct {
+	AuditID    int64
+	AuthorID   int64
+	At         time.Time
+	Status     string
+	Priority   string
+	Type       string
+	AssigneeID int64
+	GroupID    int64
+	Tags       []string
+
+	// CustomFields holds custom field values keyed by custom field ID
+	CustomFields map[int64]AuditValue
+
+	// Fields holds every field seen so far keyed by the audit field name,
+	// including the ones which are also exposed above
+	Fields map[string]AuditValue
+}
+
+// TicketFieldDiff is a change of a single field made by an audit
+type TicketFieldDiff struct {
+	FieldName     string
+	Value         AuditValue
+	PreviousValue AuditValue
+}
+
+// TicketTimelineEntry is a snapshot of the ticket and the diffs
+// introduced by the audit which produced it
+type TicketTimelineEntry struct {
+	Audit    TicketAudit
+	Snapshot TicketSnapshot
+	Diffs    []TicketFieldDiff
+}
+
+// TicketTimeline is the history of a ticket reconstructed from its audits
+type TicketTimeline struct {
+	TicketID int64
+	Entries  []TicketTimelineEntry
+}
+
+// NewTicketTimelineFromIterator consumes an iterator returned by
+// GetTicketAuditsIterator and reconstructs the ticket timeline
+func NewTicketTimelineFromIterator(it *Iterator[TicketAudit]) (*TicketTimeline, error) {
+	var audits []TicketAudit
+	for it.HasMore() {
+		page, err := it.GetNext()
+		if err != nil {
+			return nil, err
+		}
+		audits = append(audits, page...)
+	}
+	return NewTicketTimeline(audits), nil
+}
+
+// NewTicketTimeline reconstructs the ticket timeline from its audits.
+// Audits are replayed in chronological order regardless of the input order.
+func NewTicketTimeline(audits []TicketAudit) *TicketTimeline {
+	sorted := make([]TicketAudit, len(audits))
+	copy(sorted, audits)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ti, tj := auditTime(sorted[i]), auditTime(sorted[j])
+		if ti.Equal(tj) {
+			return sorted[i].ID < sorted[j].ID
+		}
+		return ti.Before(tj)
+	})
+
+	timeline := &TicketTimeline{}
+	fields := map[string]AuditValue{}
+	for _, audit := range sorted {
+		if timeline.TicketID == 0 {
+			timeline.TicketID = audit.TicketID
+		}
+
+		var diffs []TicketFieldDiff
+		for _, event := range audit.Events {
+			switch e := event.(type) {
+			case AuditCreateEvent:
+				diffs = append(diffs, TicketFieldDiff{
+					FieldName:     e.FieldName,
+					Value:         e.Value,
+					PreviousValue: fields[e.FieldName],
+				})
+				fields[e.FieldName] = e.Value
+			case AuditChangeEvent:
+				diffs = append(diffs, TicketFieldDiff{
+					FieldName:     e.FieldName,
+					Value:         e.Value,
+					PreviousValue: e.PreviousValue,
+				})
+				fields[e.FieldName] = e.Value
+			}
+		}
+
+		timeline.Entries = append(timeline.Entries, TicketTimelineEntry{
+			Audit:    audit,
+			Snapshot: newTicketSnapshot(audit, fields),
+			Diffs:    diffs,
+		})
+	}
+
+	return timeline
+}
+
+func auditTime(audit TicketAudit) time.Time {
+	if audit.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *audit.CreatedAt
+}
+
+func newTicketSnapshot(audit TicketAudit, fields map[string]AuditValue) TicketSnapshot {
+	snapshot := TicketSnapshot{
+		AuditID:      audit.ID,
+		AuthorID:     audit.AuthorID,
+		At:           auditTime(audit),
+		CustomFields: map[int64]AuditValue{},
+		Fields:       make(map[string]AuditValue, len(fields)),
+	}
+
+	for name, value := range fields {
+		snapshot.Fields[name] = value
+
+		switch name {
+		case "status":
+			snapshot.Status = value.String()
+		case "priority":
+			snapshot.Priority = value.String()
+		case "type":
+			snapshot.Type = value.String()
+		case "assignee_id":
+			snapshot.AssigneeID, _ = value.Int64()
+		case "group_id":
+			snapshot.GroupID, _ = value.Int64()
+		case "tags":
+			snapshot.Tags = append([]string(nil), value.Strings()...)
+		default:
+			// custom fields are audited with their ID as field name
+			if id, err := strconv.ParseInt(name, 10, 64); err == nil {
+				snapshot.CustomFields[id] = value
+			}
+		}
+	}
+
+	return snapshot
+}
+
+// StateAt returns the snapshot in effect at the given time.
+// ok is false if t is before the first audit.
+func (t *TicketTimeline) StateAt(at time.Time) (snapshot TicketSnapshot, ok bool) {
+	for _, entry := range t.Entries {
+		if entry.Snapshot.At.After(at) {
+			break
+		}
+		snapshot, ok = entry.Snapshot, true
+	}
+	return snapshot, ok
+}
+
+// FieldDurations returns how long the given field held each value.
+// The last value is counted until the time passed as until.
+func (t *TicketTimeline) FieldDurations(fieldName string, until time.Time) map[string]time.Duration {
+	durations := map[string]time.Duration{}
+	for i, entry := range t.Entries {
+		value, ok := entry.Snapshot.Fields[fieldName]
+		if !ok {
+			continue
+		}
+
+		end := until
+		if i+1 < len(t.Entries) {
+			end = t.Entries[i+1].Snapshot.At
+		}
+		if end.After(entry.Snapshot.At) {
+			durations[value.String()] += end.Sub(entry.Snapshot.At)
+		}
+	}
+	return durations
+}
+
+// StatusDurations returns how long the ticket spent in each status
+func (t *TicketTimeline) StatusDurations(until time.Time) map[string]time.Duration {
+	return t.FieldDurations("status", until)
+}
+
+// GroupDurations returns how long the ticket spent in each group.
+// Time spent without group is counted under ID 0.
+func (t *TicketTimeline) GroupDurations(until time.Time) map[int64]time.Duration {
+	return int64FieldDurations(t.FieldDurations("group_id", until))
+}
+
+// AssigneeDurations returns how long the ticket was assigned to each agent.
+// Time spent unassigned is counted under ID 0.
+func (t *TicketTimeline) AssigneeDurations(until time.Time) map[int64]time.Duration {
+	return int64FieldDurations(t.FieldDurations("assignee_id", until))
+}
+
+func int64FieldDurations(in map[string]time.Duration) map[int64]time.Duration {
+	out := make(map[int64]time.Duration, len(in))
+	for value, d := range in {
+		id, _ := strconv.ParseInt(value, 10, 64)
+		out[id] += d
+	}
+	return out
+}
@@ -0,0 +1,101 @@
+package zendesk
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newTestTicketTimeline(t *testing.T) *TicketTimeline {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_audits_timeline.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ops := NewPaginationOptions()
+	ops.Id = 666
+	ops.IsCBP = false
+	timeline, err := NewTicketTimelineFromIterator(client.GetTicketAuditsIterator(ctx, ops))
+	if err != nil {
+		t.Fatalf("Failed to build ticket timeline: %s", err)
+	}
+	return timeline
+}
+
+func TestNewTicketTimeline(t *testing.T) {
+	timeline := newTestTicketTimeline(t)
+
+	if timeline.TicketID != 666 {
+		t.Fatalf("expected ticket id %d, but got %d", 666, timeline.TicketID)
+	}
+	if len(timeline.Entries) != 3 {
+		t.Fatalf("expected length of entries is %d, but got %d", 3, len(timeline.Entries))
+	}
+
+	first := timeline.Entries[0].Snapshot
+	if first.AuditID != 1 || first.Status != "new" || first.GroupID != 10 || first.AssigneeID != 0 {
+		t.Fatalf("unexpected first snapshot: %+v", first)
+	}
+
+	second := timeline.Entries[1]
+	if second.Snapshot.Status != "open" || second.Snapshot.AssigneeID != 200 || second.Snapshot.GroupID != 20 {
+		t.Fatalf("unexpected second snapshot: %+v", second.Snapshot)
+	}
+	if len(second.Diffs) != 3 {
+		t.Fatalf("expected length of diffs is %d, but got %d", 3, len(second.Diffs))
+	}
+	if !reflect.DeepEqual(second.Snapshot.Tags, []string{"billing"}) {
+		t.Fatalf("expected tags to be carried over, but got %v", second.Snapshot.Tags)
+	}
+
+	last := timeline.Entries[2].Snapshot
+	if last.Status != "solved" || !reflect.DeepEqual(last.Tags, []string{"billing", "refund"}) {
+		t.Fatalf("unexpected last snapshot: %+v", last)
+	}
+	if v := last.CustomFields[360001]; v.String() != "resolved" {
+		t.Fatalf("expected custom field value %s, but got %s", "resolved", v)
+	}
+}
+
+func TestTicketTimelineStateAt(t *testing.T) {
+	timeline := newTestTicketTimeline(t)
+
+	if _, ok := timeline.StateAt(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)); ok {
+		t.Fatalf("expected no state before the first audit")
+	}
+
+	snapshot, ok := timeline.StateAt(time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC))
+	if !ok || snapshot.Status != "open" {
+		t.Fatalf("expected status %s, but got %s", "open", snapshot.Status)
+	}
+}
+
+func TestTicketTimelineDurations(t *testing.T) {
+	timeline := newTestTicketTimeline(t)
+	until := time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC)
+
+	expectedStatus := map[string]time.Duration{
+		"new":    2 * time.Hour,
+		"open":   24 * time.Hour,
+		"solved": 24 * time.Hour,
+	}
+	if d := timeline.StatusDurations(until); !reflect.DeepEqual(d, expectedStatus) {
+		t.Fatalf("expected status durations %v, but got %v", expectedStatus, d)
+	}
+
+	expectedGroup := map[int64]time.Duration{
+		10: 2 * time.Hour,
+		20: 48 * time.Hour,
+	}
+	if d := timeline.GroupDurations(until); !reflect.DeepEqual(d, expectedGroup) {
+		t.Fatalf("expected group durations %v, but got %v", expectedGroup, d)
+	}
+
+	expectedAssignee := map[int64]time.Duration{
+		0:   2 * time.Hour,
+		200: 48 * time.Hour,
+	}
+	if d := timeline.AssigneeDurations(until); !reflect.DeepEqual(d, expectedAssignee) {
+		t.Fatalf("expected assignee durations %v, but got %v", expectedAssignee, d)
+	}
+}
@@ -0,0 +1,494 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Actions which have side effects only, such as notifications,
+// and do not change the fields of the ticket
+var triggerSideEffectActions = map[string]bool{
+	"notification_user":      true,
+	"notification_group":     true,
+	"notification_target":    true,
+	"notification_webhook":   true,
+	"tweet_requester":        true,
+	"cc":                     true,
+	"follower":               true,
+	"share_ticket":           true,
+	"comment_value":          true,
+	"comment_value_html":     true,
+	"comment_mode_is_public": true,
+}
+
+// TriggerSimulationIssue is a condition or an action which could not be evaluated locally.
+// Condition or Action is set if the issue is about one of them, and neither is set
+// if the trigger itself is invalid.
+type TriggerSimulationIssue struct {
+	Trigger   Trigger
+	Condition *TriggerCondition
+	Action    *TriggerAction
+	Reason    string
+}
+
+// String returns the issue in the form of "trigger 1 (title): reason"
+func (i TriggerSimulationIssue) String() string {
+	return fmt.Sprintf("trigger %d (%s): %s", i.Trigger.ID, i.Trigger.Title, i.Reason)
+}
+
+// TriggerSimulation is the result of TriggerSimulator.Simulate
+type TriggerSimulation struct {
+	// Fired is the triggers which fired, in order of firing
+	Fired []Trigger
+
+	// Ticket is the ticket after the actions of the fired triggers are applied
+	Ticket Ticket
+
+	// Unsupported is the conditions and actions which could not be evaluated.
+	// Triggers with an unsupported condition or without Conditions.All never fire.
+	Unsupported []TriggerSimulationIssue
+}
+
+// TriggerSimulator runs triggers against a ticket update locally
+// to preview which triggers would fire.
+//
+// As Zendesk does, triggers are evaluated in order of Position and the cycle
+// restarts from the first trigger every time one fires, until no trigger fires.
+// Each trigger fires at most once per update, and inactive triggers are skipped.
+type TriggerSimulator struct {
+	triggers []Trigger
+}
+
+// NewTriggerSimulator creates a simulator of the triggers
+func NewTriggerSimulator(triggers []Trigger) *TriggerSimulator {
+	sorted := make([]Trigger, len(triggers))
+	copy(sorted, triggers)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	return &TriggerSimulator{triggers: sorted}
+}
+
+// Simulate runs the triggers against the update from before to after.
+// before is nil when the ticket is created by the update.
+// The comment of the update is read from after.Comment.
+func (s *TriggerSimulator) Simulate(before *Ticket, after Ticket) TriggerSimulation {
+	state := triggerState{
+		created: before == nil,
+		ticket:  copyTriggerTicket(after),
+	}
+	if before != nil {
+		state.before = *before
+	}
+
+	var result TriggerSimulation
+	var candidates []Trigger
+	for _, t := range s.triggers {
+		if !t.Active {
+			continue
+		}
+
+		issues := state.unsupportedConditions(t)
+		if len(issues) > 0 {
+			result.Unsupported = append(result.Unsupported, issues...)
+			continue
+		}
+		candidates = append(candidates, t)
+	}
+
+	fired := make([]bool, len(candidates))
+	for restart := true; restart; {
+		restart = false
+		for i, t := range candidates {
+			if fired[i] || !state.matches(t) {
+				continue
+			}
+
+			fired[i] = true
+			result.Fired = append(result.Fired, t)
+			result.Unsupported = append(result.Unsupported, state.apply(t)...)
+			restart = true
+			break
+		}
+	}
+
+	result.Ticket = state.ticket
+	return result
+}
+
+// triggerState is the ticket being updated by triggers
+type triggerState struct {
+	before  Ticket
+	created bool
+	ticket  Ticket
+}
+
+func (s *triggerState) unsupportedConditions(t Trigger) []TriggerSimulationIssue {
+	// Zendesk requires at least one condition in Conditions.All
+	if len(t.Conditions.All) == 0 {
+		return []TriggerSimulationIssue{{Trigger: t, Reason: "trigger has no all conditions"}}
+	}
+
+	var issues []TriggerSimulationIssue
+	conditions := append(append([]TriggerCondition{}, t.Conditions.All...), t.Conditions.Any...)
+	for i := range conditions {
+		if _, err := s.evaluate(conditions[i]); err != nil {
+			issues = append(issues, TriggerSimulationIssue{
+				Trigger:   t,
+				Condition: &conditions[i],
+				Reason:    err.Error(),
+			})
+		}
+	}
+	return issues
+}
+
+// matches returns true if the ticket satisfies all the conditions of Conditions.All
+// and at least one of Conditions.Any. Conditions are checked by unsupportedConditions beforehand.
+func (s *triggerState) matches(t Trigger) bool {
+	for _, c := range t.Conditions.All {
+		if ok, _ := s.evaluate(c); !ok {
+			return false
+		}
+	}
+
+	if len(t.Conditions.Any) == 0 {
+		return true
+	}
+	for _, c := range t.Conditions.Any {
+		if ok, _ := s.evaluate(c); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *triggerState) evaluate(c TriggerCondition) (bool, error) {
+	value, ok := triggerValueString(c.Value)
+	if !ok {
+		return false, fmt.Errorf("unsupported value for condition %s: %v", c.Field, c.Value)
+	}
+
+	switch c.Field {
+	case "update_type":
+		updateType := "Change"
+		if s.created {
+			updateType = "Create"
+		}
+		return matchTriggerIs(c, updateType == value)
+	case "comment_is_public":
+		public := "not_relevant"
+		if comment := s.ticket.Comment; comment != nil {
+			public = strconv.FormatBool(comment.Public == nil || *comment.Public)
+		}
+		return matchTriggerIs(c, public == value)
+	case "ticket_is_public":
+		public := "private"
+		if s.ticket.IsPublic {
+			public = "public"
+		}
+		return matchTriggerIs(c, public == value)
+	case "current_tags":
+		return matchTriggerTags(c, s.ticket.Tags, value)
+	case "subject_includes_word":
+		return matchTriggerWords(c, s.ticket.Subject, value)
+	case "description_includes_word":
+		return matchTriggerWords(c, s.ticket.Description, value)
+	case "comment_includes_word":
+		var body string
+		if s.ticket.Comment != nil {
+			body = s.ticket.Comment.Body
+		}
+		return matchTriggerWords(c, body, value)
+	}
+
+	current, ok := triggerFieldValue(c.Field, s.ticket)
+	if !ok {
+		return false, fmt.Errorf("unsupported condition field: %s", c.Field)
+	}
+	previous, _ := triggerFieldValue(c.Field, s.before)
+	changed := current != previous
+
+	switch c.Operator {
+	case "is":
+		return current == value, nil
+	case "is_not":
+		return current != value, nil
+	case "less_than", "greater_than":
+		return matchTriggerOrdered(c, current, value)
+	case "changed":
+		return changed, nil
+	case "not_changed":
+		return !changed, nil
+	case "value", "changed_to":
+		return changed && current == value, nil
+	case "not_value", "not_changed_to":
+		return !(changed && current == value), nil
+	case "value_previous", "changed_from":
+		return changed && previous == value, nil
+	case "not_value_previous", "not_changed_from":
+		return !(changed && previous == value), nil
+	}
+	return false, fmt.Errorf("unsupported condition operator for %s: %s", c.Field, c.Operator)
+}
+
+// apply applies the actions of the trigger to the ticket
+// and returns the actions which could not be applied
+func (s *triggerState) apply(t Trigger) []TriggerSimulationIssue {
+	var issues []TriggerSimulationIssue
+	for i := range t.Actions {
+		if err := s.applyAction(t.Actions[i]); err != nil {
+			issues = append(issues, TriggerSimulationIssue{
+				Trigger: t,
+				Action:  &t.Actions[i],
+				Reason:  err.Error(),
+			})
+		}
+	}
+	return issues
+}
+
+func (s *triggerState) applyAction(a TriggerAction) error {
+	if triggerSideEffectActions[a.Field] {
+		return nil
+	}
+
+	value, ok := triggerValueString(a.Value)
+	if !ok {
+		return fmt.Errorf("unsupported value for action %s: %v", a.Field, a.Value)
+	}
+
+	parseID := func() (int64, error) {
+		if value == "" {
+			return 0, nil
+		}
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("unsupported value for action %s: %s", a.Field, value)
+		}
+		return id, nil
+	}
+
+	// IDs are assigned only if the value is parsed, so an unsupported value leaves the ticket unchanged
+	setID := func(id *int64) error {
+		parsed, err := parseID()
+		if err == nil {
+			*id = parsed
+		}
+		return err
+	}
+
+	var err error
+	switch {
+	case a.Field == "status":
+		s.ticket.Status = value
+	case a.Field == "priority":
+		s.ticket.Priority = value
+	case a.Field == "type":
+		s.ticket.Type = value
+	case a.Field == "subject":
+		s.ticket.Subject = value
+	case a.Field == "group_id":
+		s.ticket.GroupID = json.Number(value)
+	case a.Field == "assignee_id":
+		err = setID(&s.ticket.AssigneeID)
+	case a.Field == "requester_id":
+		err = setID(&s.ticket.RequesterID)
+	case a.Field == "organization_id":
+		err = setID(&s.ticket.OrganizationID)
+	case a.Field == "brand_id":
+		err = setID(&s.ticket.BrandID)
+	case a.Field == "ticket_form_id":
+		err = setID(&s.ticket.TicketFormID)
+	case a.Field == "set_tags":
+		s.ticket.Tags = strings.Fields(value)
+	case a.Field == "current_tags":
+		for _, tag := range strings.Fields(value) {
+			if !containsString(s.ticket.Tags, tag) {
+				s.ticket.Tags = append(s.ticket.Tags, tag)
+			}
+		}
+	case a.Field == "remove_tags":
+		remove := strings.Fields(value)
+		tags := s.ticket.Tags[:0]
+		for _, tag := range s.ticket.Tags {
+			if !containsString(remove, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		s.ticket.Tags = tags
+	case strings.HasPrefix(a.Field, "custom_fields_"):
+		var id int64
+		id, err = strconv.ParseInt(strings.TrimPrefix(a.Field, "custom_fields_"), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unsupported action field: %s", a.Field)
+		}
+		s.setCustomField(id, value)
+	default:
+		return fmt.Errorf("unsupported action field: %s", a.Field)
+	}
+	return err
+}
+
+func (s *triggerState) setCustomField(id int64, value string) {
+	for i, cf := range s.ticket.CustomFields {
+		if cf.ID == id {
+			s.ticket.CustomFields[i].Value = value
+			return
+		}
+	}
+	s.ticket.CustomFields = append(s.ticket.CustomFields, CustomField{ID: id, Value: value})
+}
+
+// copyTriggerTicket copies the ticket so that actions do not modify slices of the caller
+func copyTriggerTicket(ticket Ticket) Ticket {
+	ticket.Tags = append([]string(nil), ticket.Tags...)
+	ticket.CustomFields = append([]CustomField(nil), ticket.CustomFields...)
+	return ticket
+}
+
+// triggerFieldValue returns the value of the ticket compared by operators such as is and changed
+func triggerFieldValue(field string, ticket Ticket) (string, bool) {
+	switch field {
+	case "status":
+		return ticket.Status, true
+	case "priority":
+		return ticket.Priority, true
+	case "recipient":
+		return ticket.Recipient, true
+	}
+	return slaFilterValue(field, ticket)
+}
+
+// triggerValueString returns the value of a condition or an action as string.
+// Arrays of strings are joined with a space in the same way Zendesk represents tags.
+func triggerValueString(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case nil:
+		return "", true
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case json.Number:
+		return v.String(), true
+	case []string:
+		return strings.Join(v, " "), true
+	case []interface{}:
+		list := make([]string, len(v))
+		for i, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return "", false
+			}
+			list[i] = s
+		}
+		return strings.Join(list, " "), true
+	}
+	return "", false
+}
+
+func matchTriggerIs(c TriggerCondition, equal bool) (bool, error) {
+	switch c.Operator {
+	case "is":
+		return equal, nil
+	case "is_not":
+		return !equal, nil
+	}
+	return false, fmt.Errorf("unsupported condition operator for %s: %s", c.Field, c.Operator)
+}
+
+func matchTriggerOrdered(c TriggerCondition, current, value string) (bool, error) {
+	var order []string
+	switch c.Field {
+	case "status":
+		order = slaStatusOrder
+	case "priority":
+		order = slaPriorityOrder
+	default:
+		return false, fmt.Errorf("unsupported condition operator for %s: %s", c.Field, c.Operator)
+	}
+
+	index := func(s string) int {
+		for i, o := range order {
+			if o == s {
+				return i
+			}
+		}
+		return -1
+	}
+	actual, expected := index(current), index(value)
+	if expected < 0 {
+		return false, fmt.Errorf("invalid condition value for %s: %s", c.Field, value)
+	}
+	if actual < 0 {
+		return false, nil
+	}
+
+	if c.Operator == "less_than" {
+		return actual < expected, nil
+	}
+	return actual > expected, nil
+}
+
+func matchTriggerTags(c TriggerCondition, tags []string, value string) (bool, error) {
+	included := false
+	for _, tag := range strings.Fields(value) {
+		if containsString(tags, tag) {
+			included = true
+			break
+		}
+	}
+
+	switch c.Operator {
+	case "includes":
+		return included, nil
+	case "not_includes":
+		return !included, nil
+	}
+	return false, fmt.Errorf("unsupported condition operator for %s: %s", c.Field, c.Operator)
+}
+
+// matchTriggerWords matches words in text case-insensitively.
+// includes and not_includes test each of the words, while is and is_not test the whole string.
+func matchTriggerWords(c TriggerCondition, text, value string) (bool, error) {
+	text = strings.ToLower(text)
+	value = strings.ToLower(value)
+
+	switch c.Operator {
+	case "is":
+		return strings.Contains(text, value), nil
+	case "is_not":
+		return !strings.Contains(text, value), nil
+	}
+
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' && r != '\''
+	})
+	included := false
+	for _, w := range strings.Fields(value) {
+		if containsString(words, w) {
+			included = true
+			break
+		}
+	}
+
+	switch c.Operator {
+	case "includes":
+		return included, nil
+	case "not_includes":
+		return !included, nil
+	}
+	return false, fmt.Errorf("unsupported condition operator for %s: %s", c.Field, c.Operator)
+}
@@ -0,0 +1,167 @@
+package zendesk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestTrigger(id, position int64, all, any []Condition, actions ...Action) Trigger {
+	t := Trigger{ID: id, Title: "trigger", Active: true, Position: position}
+	for _, c := range all {
+		t.Conditions.All = append(t.Conditions.All, c.TriggerCondition())
+	}
+	for _, c := range any {
+		t.Conditions.Any = append(t.Conditions.Any, c.TriggerCondition())
+	}
+	for _, a := range actions {
+		t.Actions = append(t.Actions, a.TriggerAction())
+	}
+	return t
+}
+
+func firedTriggerIDs(simulation TriggerSimulation) []int64 {
+	var ids []int64
+	for _, t := range simulation.Fired {
+		ids = append(ids, t.ID)
+	}
+	return ids
+}
+
+func TestTriggerSimulatorSimulate(t *testing.T) {
+	triggers := []Trigger{
+		// fires only after trigger 2 adds the tag
+		newTestTrigger(1, 1,
+			[]Condition{Cond.CurrentTags.Includes("escalated")},
+			nil,
+			Act.Priority("urgent"),
+		),
+		newTestTrigger(2, 2,
+			[]Condition{Cond.Status.ChangedTo("open")},
+			[]Condition{Cond.Type.Is("incident"), Cond.Type.Is("problem")},
+			Act.AddTags("escalated"),
+			Act.NotifyGroup("group_id", "Escalated", "{{ticket.id}}"),
+		),
+		newTestTrigger(3, 3,
+			[]Condition{Cond.Priority.GreaterThan("normal"), Cond.GroupID.NotChanged()},
+			nil,
+			Act.GroupID(20),
+		),
+		// does not fire as the condition never holds
+		newTestTrigger(4, 4,
+			[]Condition{Cond.Status.Is("solved")},
+			nil,
+			Act.Status("closed"),
+		),
+	}
+
+	before := Ticket{ID: 1, Status: "new", Priority: "normal", Type: "incident", GroupID: "10"}
+	after := before
+	after.Status = "open"
+
+	// trigger 3 fires in the next cycle after trigger 1 raises the priority
+	simulation := NewTriggerSimulator(triggers).Simulate(&before, after)
+	if ids := firedTriggerIDs(simulation); !reflect.DeepEqual(ids, []int64{2, 1, 3}) {
+		t.Fatalf("expected triggers 2, 1 and 3 to fire, but got %v", ids)
+	}
+	if len(simulation.Unsupported) != 0 {
+		t.Fatalf("expected no unsupported conditions, but got %v", simulation.Unsupported)
+	}
+
+	ticket := simulation.Ticket
+	if ticket.Priority != "urgent" || ticket.GroupID != "20" || !reflect.DeepEqual(ticket.Tags, []string{"escalated"}) {
+		t.Fatalf("unexpected ticket: %+v", ticket)
+	}
+}
+
+func TestTriggerSimulatorSimulateCreate(t *testing.T) {
+	public := false
+	triggers := []Trigger{
+		newTestTrigger(1, 1,
+			[]Condition{Cond.UpdateType.Is("Create"), Cond.CommentIsPublic.IsFalse()},
+			nil,
+			Act.SetTags("internal"),
+		),
+		newTestTrigger(2, 2,
+			[]Condition{Cond.SubjectIncludesWord.Includes("refund", "chargeback")},
+			nil,
+			Act.CustomField(360001, "billing"),
+			Act.RemoveTags("internal"),
+		),
+	}
+	triggers[1].Active = false
+
+	ticket := Ticket{Subject: "Refund request", Tags: []string{"web"}, Comment: &TicketComment{Body: "hi", Public: &public}}
+	simulation := NewTriggerSimulator(triggers).Simulate(nil, ticket)
+	if ids := firedTriggerIDs(simulation); !reflect.DeepEqual(ids, []int64{1}) {
+		t.Fatalf("expected trigger 1 to fire, but got %v", ids)
+	}
+
+	triggers[1].Active = true
+	simulation = NewTriggerSimulator(triggers).Simulate(nil, ticket)
+	if ids := firedTriggerIDs(simulation); !reflect.DeepEqual(ids, []int64{1, 2}) {
+		t.Fatalf("expected triggers 1 and 2 to fire, but got %v", ids)
+	}
+	if len(simulation.Ticket.Tags) != 0 {
+		t.Fatalf("expected tags to be removed, but got %v", simulation.Ticket.Tags)
+	}
+	if cf := simulation.Ticket.CustomFields; len(cf) != 1 || cf[0].ID != 360001 || cf[0].Value != "billing" {
+		t.Fatalf("unexpected custom fields: %v", cf)
+	}
+	if !reflect.DeepEqual(ticket.Tags, []string{"web"}) || ticket.CustomFields != nil {
+		t.Fatal("expected the input ticket not to be modified")
+	}
+}
+
+func TestTriggerSimulatorUnsupported(t *testing.T) {
+	triggers := []Trigger{
+		newTestTrigger(1, 1,
+			[]Condition{Cond.Status.Is("open")},
+			[]Condition{Cond.Reopens.GreaterThan(1)},
+			Act.Status("pending"),
+		),
+		newTestTrigger(2, 2,
+			[]Condition{Cond.Status.Is("open")},
+			nil,
+			Act.AssigneeToCurrentUser(),
+			Act.Comment("Thanks"),
+			// Ticket has no fields for the locale and the satisfaction rating
+			Act.LocaleID(8),
+			Act.SatisfactionScore("offered"),
+		),
+		// never fires as Zendesk requires at least one all condition
+		newTestTrigger(3, 3,
+			nil,
+			[]Condition{Cond.Status.Is("open")},
+			Act.Status("solved"),
+		),
+	}
+
+	ticket := Ticket{Status: "open", AssigneeID: 100}
+	simulation := NewTriggerSimulator(triggers).Simulate(&ticket, ticket)
+	if ids := firedTriggerIDs(simulation); !reflect.DeepEqual(ids, []int64{2}) {
+		t.Fatalf("expected trigger 2 to fire, but got %v", ids)
+	}
+
+	issues := simulation.Unsupported
+	if len(issues) != 5 {
+		t.Fatalf("expected 5 issues, but got %v", issues)
+	}
+	if issues[0].Trigger.ID != 1 || issues[0].Condition == nil || issues[0].Condition.Field != "reopens" {
+		t.Fatalf("unexpected issue: %v", issues[0])
+	}
+	if issues[1].Trigger.ID != 3 || issues[1].Condition != nil || issues[1].Action != nil {
+		t.Fatalf("unexpected issue: %v", issues[1])
+	}
+	if issues[2].Trigger.ID != 2 || issues[2].Action == nil || issues[2].Action.Field != "assignee_id" {
+		t.Fatalf("unexpected issue: %v", issues[2])
+	}
+	if issues[3].Action == nil || issues[3].Action.Field != "locale_id" {
+		t.Fatalf("unexpected issue: %v", issues[3])
+	}
+	if issues[4].Action == nil || issues[4].Action.Field != "satisfaction_score" {
+		t.Fatalf("unexpected issue: %v", issues[4])
+	}
+	if simulation.Ticket.AssigneeID != 100 {
+		t.Fatalf("expected the assignee to be unchanged, but got %d", simulation.Ticket.AssigneeID)
+	}
+}
@@ -54,6 +54,42 @@ type User struct {
 	UpdatedAt            time.Time  `json:"updated_at,omitempty"`
 }
 
+// UserUpdate is a partial user used by bulk update requests.
+// Only the fields which are set are sent, so the other fields of the users are left unchanged.
+// ID or ExternalID identifies the user in BatchUpdateManyUsers.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#update-many-users
+type UserUpdate struct {
+	ID                  int64      `json:"id,omitempty"`
+	ExternalID          string     `json:"external_id,omitempty"`
+	Name                *string    `json:"name,omitempty"`
+	Email               *string    `json:"email,omitempty"`
+	Alias               *string    `json:"alias,omitempty"`
+	Details             *string    `json:"details,omitempty"`
+	Notes               *string    `json:"notes,omitempty"`
+	Phone               *string    `json:"phone,omitempty"`
+	Role                *string    `json:"role,omitempty"`
+	CustomRoleID        *int64     `json:"custom_role_id,omitempty"`
+	DefaultGroupID      *int64     `json:"default_group_id,omitempty"`
+	OrganizationID      *int64     `json:"organization_id,omitempty"`
+	Locale              *string    `json:"locale,omitempty"`
+	LocaleID            *int64     `json:"locale_id,omitempty"`
+	Timezone            *string    `json:"time_zone,omitempty"`
+	Moderator           *bool      `json:"moderator,omitempty"`
+	OnlyPrivateComments *bool      `json:"only_private_comments,omitempty"`
+	RestrictedAgent     *bool      `json:"restricted_agent,omitempty"`
+	Signature           *string    `json:"signature,omitempty"`
+	Suspended           *bool      `json:"suspended,omitempty"`
+	Tags                *[]string  `json:"tags,omitempty"`
+	TicketRestriction   *string    `json:"ticket_restriction,omitempty"`
+	Verified            *bool      `json:"verified,omitempty"`
+	RemotePhotoURL      *string    `json:"remote_photo_url,omitempty"`
+	UserFields          UserFields `json:"user_fields,omitempty"`
+}
+
+// UserBatchLimit is the maximum number of users accepted by the user bulk endpoints at once
+const UserBatchLimit = 100
+
 const (
 	// UserRoleEndUser end-user
 	UserRoleEndUser = iota
@@ -128,6 +164,23 @@ type UserAPI interface {
 	GetOrganizationUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User]
 	GetOrganizationUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error)
 	GetOrganizationUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, CursorPaginationMeta, error)
+	GetCurrentUser(ctx context.Context) (User, error)
+	AutocompleteUsers(ctx context.Context, name string) ([]User, error)
+	DeleteUser(ctx context.Context, userID int64) (User, error)
+	SuspendUser(ctx context.Context, userID int64) (User, error)
+	UnsuspendUser(ctx context.Context, userID int64) (User, error)
+	MergeUsers(ctx context.Context, userID, targetUserID int64) (User, error)
+	CreateManyUsers(ctx context.Context, users []User) ([]JobStatus, error)
+	CreateOrUpdateManyUsers(ctx context.Context, users []User) ([]JobStatus, error)
+	UpdateManyUsers(ctx context.Context, userIDs []int64, user UserUpdate) ([]JobStatus, error)
+	BatchUpdateManyUsers(ctx context.Context, users []UserUpdate) ([]JobStatus, error)
+	DestroyManyUsers(ctx context.Context, userIDs []int64) ([]JobStatus, error)
+	GetDeletedUsers(ctx context.Context, opts *PageOptions) ([]User, Page, error)
+	GetDeletedUser(ctx context.Context, userID int64) (User, error)
+	PermanentlyDeleteUser(ctx context.Context, userID int64) (User, error)
+	GetDeletedUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User]
+	GetDeletedUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error)
+	GetDeletedUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, CursorPaginationMeta, error)
 }
 
 // GetUsers fetch user list
@@ -298,8 +351,6 @@ func (z *Client) CreateOrUpdateUser(ctx context.Context, user User) (User, error
 	return result.User, nil
 }
 
-// TODO: CreateOrUpdateManyUsers(users []User)
-
 // GetUser get an existing user
 // ref: https://developer.zendesk.com/rest_api/docs/support/users#show-user
 func (z *Client) GetUser(ctx context.Context, userID int64) (User, error) {
@@ -357,3 +408,255 @@ func (z *Client) GetUserRelated(ctx context.Context, userID int64) (UserRelated,
 
 	return data.UserRelated, nil
 }
+
+// GetCurrentUser gets the authenticated user
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-self
+func (z *Client) GetCurrentUser(ctx context.Context) (User, error) {
+	var result struct {
+		User User `json:"user"`
+	}
+
+	body, err := z.get(ctx, "/users/me.json")
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
+// AutocompleteUsers returns users whose name starts with the given name
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#autocomplete-users
+func (z *Client) AutocompleteUsers(ctx context.Context, name string) ([]User, error) {
+	var result struct {
+		Users []User `json:"users"`
+	}
+
+	u, err := addOptions("/users/autocomplete.json", struct {
+		Name string `url:"name"`
+	}{name})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Users, nil
+}
+
+// DeleteUser soft deletes a user and returns it.
+// The user can be permanently deleted with PermanentlyDeleteUser afterwards.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#delete-user
+func (z *Client) DeleteUser(ctx context.Context, userID int64) (User, error) {
+	var result struct {
+		User User `json:"user"`
+	}
+
+	body, err := z.deleteWithBody(ctx, fmt.Sprintf("/users/%d.json", userID))
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
+// SuspendUser suspends a user
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#suspending-a-user
+func (z *Client) SuspendUser(ctx context.Context, userID int64) (User, error) {
+	return z.setUserSuspended(ctx, userID, true)
+}
+
+// UnsuspendUser unsuspends a user
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#suspending-a-user
+func (z *Client) UnsuspendUser(ctx context.Context, userID int64) (User, error) {
+	return z.setUserSuspended(ctx, userID, false)
+}
+
+// setUserSuspended sends suspended explicitly since User omits it when false
+func (z *Client) setUserSuspended(ctx context.Context, userID int64, suspended bool) (User, error) {
+	var data struct {
+		User struct {
+			Suspended bool `json:"suspended"`
+		} `json:"user"`
+	}
+	var result struct {
+		User User `json:"user"`
+	}
+	data.User.Suspended = suspended
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d.json", userID), data)
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
+// MergeUsers merges the user into the target user and returns the target user
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#merge-end-users
+func (z *Client) MergeUsers(ctx context.Context, userID, targetUserID int64) (User, error) {
+	var data struct {
+		User struct {
+			ID int64 `json:"id"`
+		} `json:"user"`
+	}
+	var result struct {
+		User User `json:"user"`
+	}
+	data.User.ID = targetUserID
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d/merge.json", userID), data)
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
+// CreateManyUsers creates users in the background.
+// Users are sent in chunks of UserBatchLimit and a job status is returned for each chunk.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#create-many-users
+func (z *Client) CreateManyUsers(ctx context.Context, users []User) ([]JobStatus, error) {
+	return chunkJobs(users, UserBatchLimit, func(users []User) (JobStatus, error) {
+		var data struct {
+			Users []User `json:"users"`
+		}
+		data.Users = users
+		return z.postJobStatus(ctx, "/users/create_many.json", data)
+	})
+}
+
+// CreateOrUpdateManyUsers creates or updates users in the background.
+// Users are sent in chunks of UserBatchLimit and a job status is returned for each chunk.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#create-or-update-many-users
+func (z *Client) CreateOrUpdateManyUsers(ctx context.Context, users []User) ([]JobStatus, error) {
+	return chunkJobs(users, UserBatchLimit, func(users []User) (JobStatus, error) {
+		var data struct {
+			Users []User `json:"users"`
+		}
+		data.Users = users
+		return z.postJobStatus(ctx, "/users/create_or_update_many.json", data)
+	})
+}
+
+// UpdateManyUsers applies the same change to users in the background.
+// IDs are sent in chunks of UserBatchLimit and a job status is returned for each chunk.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#update-many-users
+func (z *Client) UpdateManyUsers(ctx context.Context, userIDs []int64, user UserUpdate) ([]JobStatus, error) {
+	return chunkJobs(userIDs, UserBatchLimit, func(ids []int64) (JobStatus, error) {
+		var data struct {
+			User UserUpdate `json:"user"`
+		}
+		data.User = user
+		return z.putJobStatus(ctx, fmt.Sprintf("/users/update_many.json?ids=%s", joinIDs(ids)), data)
+	})
+}
+
+// BatchUpdateManyUsers applies a different change to each user in the background.
+// Each user must have ID or ExternalID set.
+// Users are sent in chunks of UserBatchLimit and a job status is returned for each chunk.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#update-many-users
+func (z *Client) BatchUpdateManyUsers(ctx context.Context, users []UserUpdate) ([]JobStatus, error) {
+	return chunkJobs(users, UserBatchLimit, func(users []UserUpdate) (JobStatus, error) {
+		var data struct {
+			Users []UserUpdate `json:"users"`
+		}
+		data.Users = users
+		return z.putJobStatus(ctx, "/users/update_many.json", data)
+	})
+}
+
+// DestroyManyUsers soft deletes users in the background.
+// IDs are sent in chunks of UserBatchLimit and a job status is returned for each chunk.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#bulk-delete-users
+func (z *Client) DestroyManyUsers(ctx context.Context, userIDs []int64) ([]JobStatus, error) {
+	return chunkJobs(userIDs, UserBatchLimit, func(ids []int64) (JobStatus, error) {
+		return z.deleteJobStatus(ctx, fmt.Sprintf("/users/destroy_many.json?ids=%s", joinIDs(ids)))
+	})
+}
+
+// GetDeletedUsers fetch soft deleted users
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#list-deleted-users
+func (z *Client) GetDeletedUsers(ctx context.Context, opts *PageOptions) ([]User, Page, error) {
+	var data struct {
+		Users []User `json:"deleted_users"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions("/deleted_users.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Users, data.Page, nil
+}
+
+// GetDeletedUser gets a soft deleted user
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-deleted-user
+func (z *Client) GetDeletedUser(ctx context.Context, userID int64) (User, error) {
+	var result struct {
+		User User `json:"deleted_user"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/deleted_users/%d.json", userID))
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
+// PermanentlyDeleteUser permanently deletes a soft deleted user, e.g. for GDPR requests.
+// The user must be deleted with DeleteUser first.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#permanently-delete-user
+func (z *Client) PermanentlyDeleteUser(ctx context.Context, userID int64) (User, error) {
+	var result struct {
+		User User `json:"deleted_user"`
+	}
+
+	body, err := z.deleteWithBody(ctx, fmt.Sprintf("/deleted_users/%d.json", userID))
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
@@ -0,0 +1,79 @@
+
+// Code generated by Script. DO NOT EDIT.
+// Source: script/codegen/main.go
+//
+// Generated by this command:
+//
+//	go run script/codegen/main.go
+
+package zendesk
+
+import (
+	"context"
+	"fmt"
+)
+
+func (z *Client) GetUserIdentitiesIterator(ctx context.Context, opts *PaginationOptions) *Iterator[UserIdentity] {
+	return &Iterator[UserIdentity]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         opts.IsCBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc:       z.GetUserIdentitiesOBP,
+		cbpFunc:       z.GetUserIdentitiesCBP,
+	}
+}
+
+func (z *Client) GetUserIdentitiesOBP(ctx context.Context, opts *OBPOptions) ([]UserIdentity, Page, error) {
+	var data struct {
+		UserIdentitys []UserIdentity `json:"identities"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OBPOptions{}
+	}
+	
+	path := fmt.Sprintf("/users/%d/identities.json", tmp.Id)
+	u, err := addOptions(path, tmp)
+	
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.UserIdentitys, data.Page, nil
+}
+
+func (z *Client) GetUserIdentitiesCBP(ctx context.Context, opts *CBPOptions) ([]UserIdentity, CursorPaginationMeta, error) {
+	var data struct {
+		UserIdentitys []UserIdentity `json:"identities"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+	
+	path := fmt.Sprintf("/users/%d/identities.json", tmp.Id)
+	u, err := addOptions(path, tmp)
+	
+	if err != nil {
+		return nil, data.Meta, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	return data.UserIdentitys, data.Meta, nil
+}
+
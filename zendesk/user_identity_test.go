@@ -0,0 +1,180 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetUserIdentities(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "user_identities.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	identities, _, err := client.GetUserIdentities(ctx, 135, nil)
+	if err != nil {
+		t.Fatalf("Failed to get user identities: %s", err)
+	}
+
+	if len(identities) != 3 {
+		t.Fatalf("expected length of identities is 3, but got %d", len(identities))
+	}
+	if identities[2].Type != UserIdentityTypePhoneNumber {
+		t.Fatalf("expected type of identity is %s, but got %s", UserIdentityTypePhoneNumber, identities[2].Type)
+	}
+}
+
+func TestGetUserIdentitiesIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "user_identities.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := NewPaginationOptions()
+	opts.IsCBP = false
+	opts.Id = 135
+
+	it := client.GetUserIdentitiesIterator(ctx, opts)
+	identities, err := it.GetNext()
+	if err != nil {
+		t.Fatalf("Failed to get user identities: %s", err)
+	}
+
+	if len(identities) != 3 {
+		t.Fatalf("expected length of identities is 3, but got %d", len(identities))
+	}
+}
+
+func TestGetUserIdentity(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "user_identity.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	identity, err := client.GetUserIdentity(ctx, 135, 77938)
+	if err != nil {
+		t.Fatalf("Failed to get user identity: %s", err)
+	}
+
+	if identity.ID != 77938 {
+		t.Fatalf("expected id of identity is 77938, but got %d", identity.ID)
+	}
+}
+
+func TestCreateUserIdentity(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Identity UserIdentity `json:"identity"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		if data.Identity.Type != UserIdentityTypeEmail || data.Identity.Value != "foo@bar.com" {
+			t.Fatalf("unexpected request body: %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "user_identity.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	identity, err := client.CreateUserIdentity(ctx, 135, UserIdentity{
+		Type:  UserIdentityTypeEmail,
+		Value: "foo@bar.com",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create user identity: %s", err)
+	}
+
+	if identity.ID != 99102 {
+		t.Fatalf("expected id of identity is 99102, but got %d", identity.ID)
+	}
+}
+
+func TestUpdateUserIdentity(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "user_identity.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	identity, err := client.UpdateUserIdentity(ctx, 135, 99102, UserIdentity{Verified: true})
+	if err != nil {
+		t.Fatalf("Failed to update user identity: %s", err)
+	}
+
+	if !identity.Verified {
+		t.Fatal("expected identity to be verified")
+	}
+}
+
+func TestMakeUserIdentityPrimary(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/users/135/identities/99102/make_primary.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "user_identities_make_primary.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	identities, err := client.MakeUserIdentityPrimary(ctx, 135, 99102)
+	if err != nil {
+		t.Fatalf("Failed to make user identity primary: %s", err)
+	}
+
+	if len(identities) != 2 || !identities[1].Primary {
+		t.Fatalf("unexpected identities %v", identities)
+	}
+}
+
+func TestVerifyUserIdentity(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/135/identities/99102/verify.json" {
+			t.Fatalf("unexpected request path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "user_identity.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	identity, err := client.VerifyUserIdentity(ctx, 135, 99102)
+	if err != nil {
+		t.Fatalf("Failed to verify user identity: %s", err)
+	}
+
+	if !identity.Verified {
+		t.Fatal("expected identity to be verified")
+	}
+}
+
+func TestRequestUserIdentityVerification(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/135/identities/99102/request_verification.json" {
+			t.Fatalf("unexpected request path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.RequestUserIdentityVerification(ctx, 135, 99102)
+	if err != nil {
+		t.Fatalf("Failed to request user identity verification: %s", err)
+	}
+}
+
+func TestDeleteUserIdentity(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteUserIdentity(ctx, 135, 99102)
+	if err != nil {
+		t.Fatalf("Failed to delete user identity: %s", err)
+	}
+}
@@ -0,0 +1,64 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// UserPasswordAPI an interface containing all user password related methods
+type UserPasswordAPI interface {
+	SetUserPassword(ctx context.Context, userID int64, password string) error
+	ChangeUserPassword(ctx context.Context, userID int64, previousPassword, password string) error
+	GetUserPasswordRequirements(ctx context.Context, userID int64) ([]string, error)
+}
+
+// SetUserPassword sets the password of a user.
+// Only admins can set passwords and only if the account allows it.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_passwords/#set-a-users-password
+func (z *Client) SetUserPassword(ctx context.Context, userID int64, password string) error {
+	var data struct {
+		Password string `json:"password"`
+	}
+	data.Password = password
+
+	_, err := z.post(ctx, fmt.Sprintf("/users/%d/password.json", userID), data)
+	return err
+}
+
+// ChangeUserPassword changes the password of the authenticated user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_passwords/#change-your-password
+func (z *Client) ChangeUserPassword(ctx context.Context, userID int64, previousPassword, password string) error {
+	var data struct {
+		PreviousPassword string `json:"previous_password"`
+		Password         string `json:"password"`
+	}
+	data.PreviousPassword = previousPassword
+	data.Password = password
+
+	_, err := z.put(ctx, fmt.Sprintf("/users/%d/password.json", userID), data)
+	return err
+}
+
+// GetUserPasswordRequirements returns the password requirements of a user
+// as human readable sentences, e.g. "must be at least 5 characters"
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_passwords/#list-password-requirements
+func (z *Client) GetUserPasswordRequirements(ctx context.Context, userID int64) ([]string, error) {
+	var result struct {
+		Requirements []string `json:"requirements"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/users/%d/password/requirements.json", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Requirements, nil
+}
@@ -0,0 +1,58 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSetUserPassword(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPost || string(body) != `{"password":"newpassword"}` {
+			t.Fatalf("unexpected request %s: %s", r.Method, body)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.SetUserPassword(ctx, 12, "newpassword")
+	if err != nil {
+		t.Fatalf("Failed to set user password: %s", err)
+	}
+}
+
+func TestChangeUserPassword(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"previous_password":"oldpassword","password":"newpassword"}`
+		if r.Method != http.MethodPut || string(body) != expected {
+			t.Fatalf("unexpected request %s: %s", r.Method, body)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ChangeUserPassword(ctx, 12, "oldpassword", "newpassword")
+	if err != nil {
+		t.Fatalf("Failed to change user password: %s", err)
+	}
+}
+
+func TestGetUserPasswordRequirements(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "password_requirements.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	requirements, err := client.GetUserPasswordRequirements(ctx, 12)
+	if err != nil {
+		t.Fatalf("Failed to get user password requirements: %s", err)
+	}
+
+	if len(requirements) != 2 {
+		t.Fatalf("expected length of requirements is 2, but got %d", len(requirements))
+	}
+}
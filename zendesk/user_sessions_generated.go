@@ -0,0 +1,79 @@
+
+// Code generated by Script. DO NOT EDIT.
+// Source: script/codegen/main.go
+//
+// Generated by this command:
+//
+//	go run script/codegen/main.go
+
+package zendesk
+
+import (
+	"context"
+	"fmt"
+)
+
+func (z *Client) GetUserSessionsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Session] {
+	return &Iterator[Session]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         opts.IsCBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc:       z.GetUserSessionsOBP,
+		cbpFunc:       z.GetUserSessionsCBP,
+	}
+}
+
+func (z *Client) GetUserSessionsOBP(ctx context.Context, opts *OBPOptions) ([]Session, Page, error) {
+	var data struct {
+		Sessions []Session `json:"sessions"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OBPOptions{}
+	}
+	
+	path := fmt.Sprintf("/users/%d/sessions.json", tmp.Id)
+	u, err := addOptions(path, tmp)
+	
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Sessions, data.Page, nil
+}
+
+func (z *Client) GetUserSessionsCBP(ctx context.Context, opts *CBPOptions) ([]Session, CursorPaginationMeta, error) {
+	var data struct {
+		Sessions []Session `json:"sessions"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+	
+	path := fmt.Sprintf("/users/%d/sessions.json", tmp.Id)
+	u, err := addOptions(path, tmp)
+	
+	if err != nil {
+		return nil, data.Meta, err
+	}
+
+	err = getData(z, ctx, u, &data)
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	return data.Sessions, data.Meta, nil
+}
+
@@ -1,6 +1,9 @@
 package zendesk
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
@@ -233,3 +236,268 @@ func TestGetUserRelated(t *testing.T) {
 		t.Fatalf("Returned user does not have the expected assigned tickets %d. It is %d", expectedAssignedTickets, userRelated.AssignedTickets)
 	}
 }
+
+func TestGetCurrentUser(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/me.json" {
+			t.Fatalf("unexpected request path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "user.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get current user: %s", err)
+	}
+
+	expectedID := int64(369531345753)
+	if user.ID != expectedID {
+		t.Fatalf("Returned user does not have the expected ID %d. User id is %d", expectedID, user.ID)
+	}
+}
+
+func TestAutocompleteUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if name := r.URL.Query().Get("name"); name != "joh" {
+			t.Fatalf("unexpected name %s", name)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "users_autocomplete.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, err := client.AutocompleteUsers(ctx, "joh")
+	if err != nil {
+		t.Fatalf("Failed to autocomplete users: %s", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected length of users is 2, but got %d", len(users))
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/users/369531345753.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodGet, "user.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.DeleteUser(ctx, 369531345753)
+	if err != nil {
+		t.Fatalf("Failed to delete user: %s", err)
+	}
+
+	if user.ID != 369531345753 {
+		t.Fatalf("Returned user does not have the expected ID. User id is %d", user.ID)
+	}
+}
+
+func TestSuspendUser(t *testing.T) {
+	for _, suspended := range []bool{true, false} {
+		mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			expected := fmt.Sprintf(`{"user":{"suspended":%t}}`, suspended)
+			if string(body) != expected {
+				t.Fatalf("expected request body %s, but got %s", expected, body)
+			}
+			w.Write(readFixture(filepath.Join(http.MethodPut, "user.json")))
+		}))
+		client := newTestClient(mockAPI)
+
+		var err error
+		if suspended {
+			_, err = client.SuspendUser(ctx, 369531345753)
+		} else {
+			_, err = client.UnsuspendUser(ctx, 369531345753)
+		}
+		mockAPI.Close()
+		if err != nil {
+			t.Fatalf("Failed to set suspended to %t: %s", suspended, err)
+		}
+	}
+}
+
+func TestMergeUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path != "/users/100/merge.json" || string(body) != `{"user":{"id":369531345753}}` {
+			t.Fatalf("unexpected request %s: %s", r.URL.Path, body)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "user.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.MergeUsers(ctx, 100, 369531345753)
+	if err != nil {
+		t.Fatalf("Failed to merge users: %s", err)
+	}
+}
+
+func TestCreateManyUsers(t *testing.T) {
+	var sizes []int
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Users []User `json:"users"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		sizes = append(sizes, len(data.Users))
+		w.Write(readFixture(filepath.Join(http.MethodPost, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users := make([]User, UserBatchLimit*2+1)
+	jobStatuses, err := client.CreateManyUsers(ctx, users)
+	if err != nil {
+		t.Fatalf("Failed to create many users: %s", err)
+	}
+
+	if len(jobStatuses) != 3 {
+		t.Fatalf("expected length of job statuses is 3, but got %d", len(jobStatuses))
+	}
+	if sizes[0] != UserBatchLimit || sizes[2] != 1 {
+		t.Fatalf("unexpected chunk sizes %v", sizes)
+	}
+}
+
+func TestCreateOrUpdateManyUsers(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPost, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobStatuses, err := client.CreateOrUpdateManyUsers(ctx, []User{{Name: "A"}, {Name: "B"}})
+	if err != nil {
+		t.Fatalf("Failed to create or update many users: %s", err)
+	}
+
+	if len(jobStatuses) != 1 || jobStatuses[0].Status != JobStatusQueued {
+		t.Fatalf("unexpected job statuses %v", jobStatuses)
+	}
+}
+
+func TestUpdateManyUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ids := r.URL.Query().Get("ids"); ids != "1,2,3" {
+			t.Fatalf("unexpected ids %s", ids)
+		}
+
+		var data struct {
+			User map[string]interface{} `json:"user"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %s", err)
+		}
+		if len(data.User) != 2 || data.User["tags"] == nil || data.User["moderator"] != false {
+			t.Fatalf("expected only tags and moderator to be sent, but got %s", body)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tags, moderator := []string{"vip"}, false
+	_, err := client.UpdateManyUsers(ctx, []int64{1, 2, 3}, UserUpdate{Tags: &tags, Moderator: &moderator})
+	if err != nil {
+		t.Fatalf("Failed to update many users: %s", err)
+	}
+}
+
+func TestBatchUpdateManyUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"users":[{"id":1,"name":"A"},{"external_id":"b","suspended":true}]}` {
+			t.Fatalf("unexpected request body %s", body)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	name, suspended := "A", true
+	_, err := client.BatchUpdateManyUsers(ctx, []UserUpdate{{ID: 1, Name: &name}, {ExternalID: "b", Suspended: &suspended}})
+	if err != nil {
+		t.Fatalf("Failed to batch update many users: %s", err)
+	}
+}
+
+func TestDestroyManyUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Query().Get("ids") != "1,2" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobStatuses, err := client.DestroyManyUsers(ctx, []int64{1, 2})
+	if err != nil {
+		t.Fatalf("Failed to destroy many users: %s", err)
+	}
+
+	if len(jobStatuses) != 1 {
+		t.Fatalf("expected length of job statuses is 1, but got %d", len(jobStatuses))
+	}
+}
+
+func TestGetDeletedUsers(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "deleted_users.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, _, err := client.GetDeletedUsers(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get deleted users: %s", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected length of deleted users is 2, but got %d", len(users))
+	}
+}
+
+func TestGetDeletedUser(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "deleted_user.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.GetDeletedUser(ctx, 189304711533)
+	if err != nil {
+		t.Fatalf("Failed to get deleted user: %s", err)
+	}
+
+	if user.ID != 189304711533 || user.Active {
+		t.Fatalf("unexpected deleted user %v", user)
+	}
+}
+
+func TestPermanentlyDeleteUser(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/deleted_users/189304711533.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "deleted_user.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.PermanentlyDeleteUser(ctx, 189304711533)
+	if err != nil {
+		t.Fatalf("Failed to permanently delete user: %s", err)
+	}
+
+	if user.ID != 189304711533 {
+		t.Fatalf("unexpected deleted user id %d", user.ID)
+	}
+}
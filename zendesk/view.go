@@ -4,25 +4,179 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
 
 type (
-	// View is struct for group membership payload
+	// ViewCondition is a condition of tickets shown in a view
+	// https://developer.zendesk.com/documentation/ticketing/reference-guides/conditions-reference/
+	ViewCondition struct {
+		Field    string      `json:"field"`
+		Operator string      `json:"operator"`
+		Value    interface{} `json:"value"`
+	}
+
+	// ViewConditions is the conditions of a view.
+	// Tickets must meet all the conditions of All and at least one of Any.
+	ViewConditions struct {
+		All []ViewCondition `json:"all"`
+		Any []ViewCondition `json:"any"`
+	}
+
+	// ViewColumn is a column or field of a view.
+	// ID is the name of a system field such as "subject", or the ID of a custom field.
+	ViewColumn struct {
+		ID    string `json:"id"`
+		Title string `json:"title,omitempty"`
+	}
+
+	// ViewExecutionOrder is the field and direction a view is grouped or sorted by
+	ViewExecutionOrder struct {
+		ID    string `json:"id"`
+		Title string `json:"title,omitempty"`
+		Order string `json:"order,omitempty"`
+	}
+
+	// ViewExecution is how a view shows tickets.
+	// Only Columns, GroupBy, GroupOrder, SortBy and SortOrder are sent on create and update.
+	ViewExecution struct {
+		GroupBy      string              `json:"group_by,omitempty"`
+		GroupOrder   string              `json:"group_order,omitempty"`
+		SortBy       string              `json:"sort_by,omitempty"`
+		SortOrder    string              `json:"sort_order,omitempty"`
+		Group        *ViewExecutionOrder `json:"group,omitempty"`
+		Sort         *ViewExecutionOrder `json:"sort,omitempty"`
+		Columns      []ViewColumn        `json:"columns,omitempty"`
+		Fields       []ViewColumn        `json:"fields,omitempty"`
+		CustomFields []ViewColumn        `json:"custom_fields,omitempty"`
+	}
+
+	// ViewRestriction restricts who can use a view.
+	// Type is "Group" or "User". A group restriction may have multiple IDs.
+	ViewRestriction struct {
+		Type string  `json:"type"`
+		ID   int64   `json:"id,omitempty"`
+		IDs  []int64 `json:"ids,omitempty"`
+	}
+
+	// View is struct for view payload
 	// https://developer.zendesk.com/api-reference/ticketing/business-rules/views/
 	View struct {
-		ID          int64     `json:"id,omitempty"`
-		Active      bool      `json:"active"`
-		Description string    `json:"description"`
-		Position    int64     `json:"position"`
-		Title       string    `json:"title"`
-		CreatedAt   time.Time `json:"created_at,omitempty"`
-		UpdatedAt   time.Time `json:"updated_at,omitempty"`
+		ID          int64            `json:"id,omitempty"`
+		URL         string           `json:"url,omitempty"`
+		Active      bool             `json:"active"`
+		Default     bool             `json:"default,omitempty"`
+		Description string           `json:"description"`
+		Position    int64            `json:"position"`
+		Title       string           `json:"title"`
+		RawTitle    string           `json:"raw_title,omitempty"`
+		Watchable   bool             `json:"watchable,omitempty"`
+		Conditions  ViewConditions   `json:"conditions"`
+		Execution   ViewExecution    `json:"execution"`
+		Restriction *ViewRestriction `json:"restriction,omitempty"`
+		CreatedAt   time.Time        `json:"created_at,omitempty"`
+		UpdatedAt   time.Time        `json:"updated_at,omitempty"`
+	}
+
+	// viewOutput is the execution of a view in the format of create and update endpoints
+	viewOutput struct {
+		Columns    []interface{} `json:"columns,omitempty"`
+		GroupBy    string        `json:"group_by,omitempty"`
+		GroupOrder string        `json:"group_order,omitempty"`
+		SortBy     string        `json:"sort_by,omitempty"`
+		SortOrder  string        `json:"sort_order,omitempty"`
+	}
+
+	// viewPayload is a view in the format of create and update endpoints.
+	// Fields are omitted when nil or empty so that an update leaves them unchanged.
+	viewPayload struct {
+		Title       *string          `json:"title,omitempty"`
+		Description *string          `json:"description,omitempty"`
+		Active      *bool            `json:"active,omitempty"`
+		Position    *int64           `json:"position,omitempty"`
+		All         []ViewCondition  `json:"all,omitempty"`
+		Any         []ViewCondition  `json:"any,omitempty"`
+		Output      *viewOutput      `json:"output,omitempty"`
+		Restriction *ViewRestriction `json:"restriction,omitempty"`
+	}
+
+	// ViewUpdate is a partial view sent to UpdateView.
+	// Only the fields which are set are sent, so the other fields of the view are left unchanged.
+	ViewUpdate struct {
+		Title       *string
+		Description *string
+		Active      *bool
+		Position    *int64
+		Conditions  *ViewConditions
+		Execution   *ViewExecution
+		Restriction *ViewRestriction
+	}
+
+	// ViewListOptions is options for GetActiveViews
+	ViewListOptions struct {
+		PageOptions
+		// Access can take "personal", "shared" or "account"
+		Access    string `url:"access,omitempty"`
+		GroupID   int64  `url:"group_id,omitempty"`
+		SortBy    string `url:"sort_by,omitempty"`
+		SortOrder string `url:"sort_order,omitempty"`
+	}
+
+	// ViewSearchOptions is options for SearchViews
+	ViewSearchOptions struct {
+		PageOptions
+		Query     string `url:"query"`
+		Active    bool   `url:"active,omitempty"`
+		Access    string `url:"access,omitempty"`
+		GroupID   int64  `url:"group_id,omitempty"`
+		SortBy    string `url:"sort_by,omitempty"`
+		SortOrder string `url:"sort_order,omitempty"`
+	}
+
+	// ViewExecuteOptions is options for ExecuteView and PreviewView
+	ViewExecuteOptions struct {
+		PageOptions
+		SortBy     string `url:"sort_by,omitempty"`
+		SortOrder  string `url:"sort_order,omitempty"`
+		GroupBy    string `url:"group_by,omitempty"`
+		GroupOrder string `url:"group_order,omitempty"`
+	}
+
+	// ViewRow is a row of an executed view.
+	// Ticket holds the basic fields of the ticket and Values holds
+	// the value of each column keyed by column ID, e.g. "subject" or "requester_id".
+	ViewRow struct {
+		Ticket Ticket
+		Values map[string]interface{}
+	}
+
+	// ViewRows is the result of ExecuteView and PreviewView.
+	// Users, Groups and Organizations are sideloaded for the IDs in the rows.
+	ViewRows struct {
+		View          View           `json:"view"`
+		Rows          []ViewRow      `json:"rows"`
+		Columns       []ViewColumn   `json:"columns"`
+		Users         []User         `json:"users"`
+		Groups        []Group        `json:"groups"`
+		Organizations []Organization `json:"organizations"`
+		Count         int64          `json:"count"`
+		Page
+	}
+
+	// ViewExport is the state of an asynchronous CSV export of a view.
+	// The CSV file is sent to the current user by email when the export finishes.
+	ViewExport struct {
+		ViewID int64  `json:"view_id"`
+		Status string `json:"status"`
+	}
 
-		// Conditions Conditions
-		// Execution Execution
-		// Restriction Restriction
+	// ViewBatchUpdate is an update of a view sent to UpdateManyViews
+	ViewBatchUpdate struct {
+		ID       int64  `json:"id"`
+		Position *int64 `json:"position,omitempty"`
+		Active   *bool  `json:"active,omitempty"`
 	}
 
 	ViewCount struct {
@@ -45,9 +199,130 @@ type (
 		GetViewsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[View]
 		GetViewsOBP(ctx context.Context, opts *OBPOptions) ([]View, Page, error)
 		GetViewsCBP(ctx context.Context, opts *CBPOptions) ([]View, CursorPaginationMeta, error)
+		CreateView(ctx context.Context, view View) (View, error)
+		UpdateView(ctx context.Context, viewID int64, view ViewUpdate) (View, error)
+		DeleteView(ctx context.Context, viewID int64) error
+		UpdateManyViews(ctx context.Context, updates []ViewBatchUpdate) ([]View, error)
+		DestroyManyViews(ctx context.Context, ids []int64) error
+		GetActiveViews(ctx context.Context, opts *ViewListOptions) ([]View, Page, error)
+		GetCompactViews(ctx context.Context) ([]View, error)
+		SearchViews(ctx context.Context, opts *ViewSearchOptions) ([]View, Page, error)
+		ExecuteView(ctx context.Context, viewID int64, opts *ViewExecuteOptions) (ViewRows, error)
+		PreviewView(ctx context.Context, view View, opts *ViewExecuteOptions) (ViewRows, error)
+		GetViewCount(ctx context.Context, viewID int64) (ViewCount, error)
+		ExportView(ctx context.Context, viewID int64) (ViewExport, error)
 	}
 )
 
+// UnmarshalJSON is unmarshaller for ViewColumn.
+// IDs of custom fields are numbers while IDs of system fields are strings.
+func (c *ViewColumn) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID    AuditValue `json:"id"`
+		Title string     `json:"title"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	c.ID = raw.ID.String()
+	c.Title = raw.Title
+	return nil
+}
+
+// UnmarshalJSON is unmarshaller for ViewRow
+func (r *ViewRow) UnmarshalJSON(data []byte) error {
+	var values map[string]interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	var raw struct {
+		Ticket Ticket `json:"ticket"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	delete(values, "ticket")
+	r.Ticket = raw.Ticket
+	r.Values = values
+	return nil
+}
+
+// Value returns the value of the column
+func (r ViewRow) Value(columnID string) (interface{}, bool) {
+	v, ok := r.Values[columnID]
+	return v, ok
+}
+
+// payload converts the view into the format of the create endpoint.
+// Active is sent only when true and Position only when not zero,
+// so that Zendesk applies its defaults otherwise.
+func (v View) payload() viewPayload {
+	p := viewPayload{
+		All:         v.Conditions.All,
+		Any:         v.Conditions.Any,
+		Output:      v.Execution.output(),
+		Restriction: v.Restriction,
+	}
+	if v.Title != "" {
+		p.Title = &v.Title
+	}
+	if v.Description != "" {
+		p.Description = &v.Description
+	}
+	if v.Active {
+		p.Active = &v.Active
+	}
+	if v.Position != 0 {
+		p.Position = &v.Position
+	}
+	return p
+}
+
+// payload converts the update into the format of the update endpoint
+func (u ViewUpdate) payload() viewPayload {
+	p := viewPayload{
+		Title:       u.Title,
+		Description: u.Description,
+		Active:      u.Active,
+		Position:    u.Position,
+		Restriction: u.Restriction,
+	}
+	if u.Conditions != nil {
+		p.All = u.Conditions.All
+		p.Any = u.Conditions.Any
+	}
+	if u.Execution != nil {
+		p.Output = u.Execution.output()
+	}
+	return p
+}
+
+// output converts the execution into the format of create and update endpoints.
+// It returns nil if no column, grouping or sorting is set.
+func (e ViewExecution) output() *viewOutput {
+	output := viewOutput{
+		GroupBy:    e.GroupBy,
+		GroupOrder: e.GroupOrder,
+		SortBy:     e.SortBy,
+		SortOrder:  e.SortOrder,
+	}
+	for _, column := range e.Columns {
+		// custom fields are sent as numbers
+		if id, err := strconv.ParseInt(column.ID, 10, 64); err == nil {
+			output.Columns = append(output.Columns, id)
+		} else {
+			output.Columns = append(output.Columns, column.ID)
+		}
+	}
+	if len(output.Columns) == 0 && output.GroupBy == "" && output.GroupOrder == "" && output.SortBy == "" && output.SortOrder == "" {
+		return nil
+	}
+	return &output
+}
+
 // GetViews gets all views
 // ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#list-views
 func (z *Client) GetViews(ctx context.Context) ([]View, Page, error) {
@@ -139,3 +414,264 @@ func (z *Client) GetCountTicketsInViews(ctx context.Context, ids []string) ([]Vi
 	}
 	return result.ViewCounts, nil
 }
+
+// CreateView creates a new view.
+// The view is created active, as Active is sent only when true; use UpdateView to deactivate it.
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#create-view
+func (z *Client) CreateView(ctx context.Context, view View) (View, error) {
+	var data struct {
+		View viewPayload `json:"view"`
+	}
+	var result struct {
+		View View `json:"view"`
+	}
+	data.View = view.payload()
+
+	body, err := z.post(ctx, "/views.json", data)
+	if err != nil {
+		return View{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return View{}, err
+	}
+
+	return result.View, nil
+}
+
+// UpdateView updates a given view. Only the fields set in the update are changed.
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#update-view
+func (z *Client) UpdateView(ctx context.Context, viewID int64, view ViewUpdate) (View, error) {
+	var data struct {
+		View viewPayload `json:"view"`
+	}
+	var result struct {
+		View View `json:"view"`
+	}
+	data.View = view.payload()
+
+	body, err := z.put(ctx, fmt.Sprintf("/views/%d.json", viewID), data)
+	if err != nil {
+		return View{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return View{}, err
+	}
+
+	return result.View, nil
+}
+
+// DeleteView deletes a given view
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#delete-view
+func (z *Client) DeleteView(ctx context.Context, viewID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/views/%d.json", viewID))
+}
+
+// UpdateManyViews updates the position or active state of multiple views
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#update-many-views
+func (z *Client) UpdateManyViews(ctx context.Context, updates []ViewBatchUpdate) ([]View, error) {
+	var data struct {
+		Views []ViewBatchUpdate `json:"views"`
+	}
+	var result struct {
+		Views []View `json:"views"`
+	}
+	data.Views = updates
+
+	body, err := z.put(ctx, "/views/update_many.json", data)
+	if err != nil {
+		return []View{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return []View{}, err
+	}
+
+	return result.Views, nil
+}
+
+// DestroyManyViews deletes multiple views
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#bulk-delete-views
+func (z *Client) DestroyManyViews(ctx context.Context, ids []int64) error {
+	return z.delete(ctx, fmt.Sprintf("/views/destroy_many.json?ids=%s", joinIDs(ids)))
+}
+
+// GetActiveViews gets active shared and personal views available to the current user
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#list-active-views
+func (z *Client) GetActiveViews(ctx context.Context, opts *ViewListOptions) ([]View, Page, error) {
+	var result struct {
+		Views []View `json:"views"`
+		Page
+	}
+	tmp := opts
+	if tmp == nil {
+		tmp = &ViewListOptions{}
+	}
+
+	url, err := addOptions("/views/active.json", tmp)
+	if err != nil {
+		return []View{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, url)
+	if err != nil {
+		return []View{}, Page{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return []View{}, Page{}, err
+	}
+
+	return result.Views, result.Page, nil
+}
+
+// GetCompactViews gets a compacted list of up to 32 active views available to the current user
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#list-views---compact
+func (z *Client) GetCompactViews(ctx context.Context) ([]View, error) {
+	var result struct {
+		Views []View `json:"views"`
+	}
+
+	body, err := z.get(ctx, "/views/compact.json")
+	if err != nil {
+		return []View{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return []View{}, err
+	}
+
+	return result.Views, nil
+}
+
+// SearchViews searches views by title
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#search-views
+func (z *Client) SearchViews(ctx context.Context, opts *ViewSearchOptions) ([]View, Page, error) {
+	var result struct {
+		Views []View `json:"views"`
+		Page
+	}
+
+	if opts == nil {
+		return []View{}, Page{}, &OptionsError{opts}
+	}
+
+	url, err := addOptions("/views/search.json", opts)
+	if err != nil {
+		return []View{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, url)
+	if err != nil {
+		return []View{}, Page{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return []View{}, Page{}, err
+	}
+
+	return result.Views, result.Page, nil
+}
+
+// ExecuteView gets the tickets of a view as rows with the columns configured in the view
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#execute-view
+func (z *Client) ExecuteView(ctx context.Context, viewID int64, opts *ViewExecuteOptions) (ViewRows, error) {
+	var result ViewRows
+	tmp := opts
+	if tmp == nil {
+		tmp = &ViewExecuteOptions{}
+	}
+
+	url, err := addOptions(fmt.Sprintf("/views/%d/execute.json", viewID), tmp)
+	if err != nil {
+		return ViewRows{}, err
+	}
+
+	body, err := z.get(ctx, url)
+	if err != nil {
+		return ViewRows{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return ViewRows{}, err
+	}
+
+	return result, nil
+}
+
+// PreviewView gets the rows of the conditions and columns of the view without saving it
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#preview-views
+func (z *Client) PreviewView(ctx context.Context, view View, opts *ViewExecuteOptions) (ViewRows, error) {
+	type preview struct {
+		All    []ViewCondition `json:"all"`
+		Any    []ViewCondition `json:"any"`
+		Output *viewOutput     `json:"output,omitempty"`
+	}
+	var data struct {
+		View preview `json:"view"`
+	}
+	var result ViewRows
+
+	p := view.payload()
+	data.View = preview{All: p.All, Any: p.Any, Output: p.Output}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ViewExecuteOptions{}
+	}
+
+	url, err := addOptions("/views/preview.json", tmp)
+	if err != nil {
+		return ViewRows{}, err
+	}
+
+	body, err := z.post(ctx, url, data)
+	if err != nil {
+		return ViewRows{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return ViewRows{}, err
+	}
+
+	return result, nil
+}
+
+// GetViewCount counts tickets in a view
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#count-tickets-in-view
+func (z *Client) GetViewCount(ctx context.Context, viewID int64) (ViewCount, error) {
+	var result struct {
+		ViewCount ViewCount `json:"view_count"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/views/%d/count.json", viewID))
+	if err != nil {
+		return ViewCount{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return ViewCount{}, err
+	}
+
+	return result.ViewCount, nil
+}
+
+// ExportView starts a CSV export of a view. The CSV file is sent by email.
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#export-view
+func (z *Client) ExportView(ctx context.Context, viewID int64) (ViewExport, error) {
+	var result struct {
+		Export ViewExport `json:"export"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/views/%d/export.json", viewID))
+	if err != nil {
+		return ViewExport{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return ViewExport{}, err
+	}
+
+	return result.Export, nil
+}
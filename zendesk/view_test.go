@@ -1,7 +1,11 @@
 package zendesk
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -50,3 +54,366 @@ func TestGetCountTicketsInViewsTestGetViews(t *testing.T) {
 		t.Fatalf("expected length of views ticket counts is 2, but got %d", len(viewsCount))
 	}
 }
+
+func TestGetViewModel(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "view.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	view, err := client.GetView(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to get view: %s", err)
+	}
+
+	if len(view.Conditions.All) != 2 || view.Conditions.All[0].Field != "status" {
+		t.Fatalf("unexpected conditions %v", view.Conditions)
+	}
+	if len(view.Execution.Columns) != 5 || view.Execution.Columns[0].ID != "subject" {
+		t.Fatalf("unexpected columns %v", view.Execution.Columns)
+	}
+	if view.Execution.Sort == nil || view.Execution.Sort.ID != "ticket_id" {
+		t.Fatalf("unexpected sort %v", view.Execution.Sort)
+	}
+	if view.Restriction != nil {
+		t.Fatalf("expected no restriction, but got %v", view.Restriction)
+	}
+}
+
+func TestViewColumnUnmarshalJSON(t *testing.T) {
+	var column ViewColumn
+	if err := json.Unmarshal([]byte(`{"id":360000123456,"title":"Product"}`), &column); err != nil {
+		t.Fatal(err)
+	}
+	if column.ID != "360000123456" {
+		t.Fatalf("expected numeric id to be converted to string, but got %s", column.ID)
+	}
+}
+
+func TestCreateView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			View map[string]interface{} `json:"view"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatal(err)
+		}
+
+		// create endpoint takes conditions and execution as all, any and output
+		if _, ok := data.View["all"]; !ok {
+			t.Fatalf("expected all conditions in %v", data.View)
+		}
+		output, _ := data.View["output"].(map[string]interface{})
+		columns, _ := output["columns"].([]interface{})
+		if len(columns) != 2 || columns[0] != "subject" || columns[1] != float64(360000123456) {
+			t.Fatalf("unexpected output columns %v", output["columns"])
+		}
+		restriction, _ := data.View["restriction"].(map[string]interface{})
+		if restriction["type"] != "Group" {
+			t.Fatalf("unexpected restriction %v", data.View["restriction"])
+		}
+
+		// active is omitted so that the view is created active by default
+		if _, ok := data.View["active"]; ok {
+			t.Fatalf("expected active not to be sent, but got %v", data.View)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "view.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	view := View{
+		Title: "Wonderful tickets",
+		Conditions: ViewConditions{
+			All: []ViewCondition{{Field: "status", Operator: "less_than", Value: "solved"}},
+		},
+		Execution: ViewExecution{
+			Columns: []ViewColumn{{ID: "subject"}, {ID: "360000123456"}},
+			SortBy:  "nice_id",
+		},
+		Restriction: &ViewRestriction{Type: "Group", IDs: []int64{1, 2}},
+	}
+
+	created, err := client.CreateView(ctx, view)
+	if err != nil {
+		t.Fatalf("Failed to create view: %s", err)
+	}
+
+	if created.ID != 360002440594 {
+		t.Fatalf("expected id of view is 360002440594, but got %d", created.ID)
+	}
+}
+
+func TestUpdateView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			View map[string]interface{} `json:"view"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatal(err)
+		}
+
+		// a title only update must leave the other fields unchanged
+		for _, key := range []string{"active", "position", "all", "any", "output", "restriction"} {
+			if _, ok := data.View[key]; ok {
+				t.Fatalf("expected %s not to be sent, but got %v", key, data.View)
+			}
+		}
+		if data.View["title"] != "Wonderful tickets" {
+			t.Fatalf("unexpected title %v", data.View["title"])
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "view.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	title := "Wonderful tickets"
+	_, err := client.UpdateView(ctx, 360002440594, ViewUpdate{Title: &title})
+	if err != nil {
+		t.Fatalf("Failed to update view: %s", err)
+	}
+}
+
+func TestUpdateViewActiveAndPosition(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"view":{"active":false,"position":0}}` {
+			t.Fatalf("unexpected request body %s", body)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "view.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	active, position := false, int64(0)
+	_, err := client.UpdateView(ctx, 360002440594, ViewUpdate{Active: &active, Position: &position})
+	if err != nil {
+		t.Fatalf("Failed to update view: %s", err)
+	}
+}
+
+func TestDeleteView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteView(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to delete view: %s", err)
+	}
+}
+
+func TestUpdateManyViews(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Views []ViewBatchUpdate `json:"views"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatal(err)
+		}
+		if r.URL.Path != "/views/update_many.json" || len(data.Views) != 2 || *data.Views[1].Position != 3 {
+			t.Fatalf("unexpected request %s %v", r.URL.Path, data.Views)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "views.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	active := false
+	position := int64(3)
+	views, err := client.UpdateManyViews(ctx, []ViewBatchUpdate{
+		{ID: 1, Active: &active},
+		{ID: 2, Position: &position},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update many views: %s", err)
+	}
+
+	if len(views) != 2 {
+		t.Fatalf("expected length of views is 2, but got %d", len(views))
+	}
+}
+
+func TestDestroyManyViews(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/views/destroy_many.json" || r.URL.Query().Get("ids") != "1,2" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DestroyManyViews(ctx, []int64{1, 2})
+	if err != nil {
+		t.Fatalf("Failed to destroy many views: %s", err)
+	}
+}
+
+func TestGetActiveViews(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/views/active.json" || r.URL.Query().Get("access") != "shared" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "views.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	views, _, err := client.GetActiveViews(ctx, &ViewListOptions{Access: "shared"})
+	if err != nil {
+		t.Fatalf("Failed to get active views: %s", err)
+	}
+
+	if len(views) != 2 {
+		t.Fatalf("expected length of views is 2, but got %d", len(views))
+	}
+}
+
+func TestGetCompactViews(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/views/compact.json" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "views.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	views, err := client.GetCompactViews(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get compact views: %s", err)
+	}
+
+	if len(views) != 2 {
+		t.Fatalf("expected length of views is 2, but got %d", len(views))
+	}
+}
+
+func TestSearchViews(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/views/search.json" || r.URL.Query().Get("query") != "wonderful" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "views.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	views, _, err := client.SearchViews(ctx, &ViewSearchOptions{Query: "wonderful"})
+	if err != nil {
+		t.Fatalf("Failed to search views: %s", err)
+	}
+
+	if len(views) != 2 {
+		t.Fatalf("expected length of views is 2, but got %d", len(views))
+	}
+
+	if _, _, err := client.SearchViews(ctx, nil); err == nil {
+		t.Fatal("expected an OptionsError, but no error")
+	}
+}
+
+func TestExecuteView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/views/360002440594/execute.json" || r.URL.Query().Get("sort_by") != "created" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "view_execute.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	rows, err := client.ExecuteView(ctx, 360002440594, &ViewExecuteOptions{SortBy: "created"})
+	if err != nil {
+		t.Fatalf("Failed to execute view: %s", err)
+	}
+
+	if len(rows.Rows) != 2 || rows.Count != 2 {
+		t.Fatalf("expected 2 rows, but got %d", len(rows.Rows))
+	}
+	if len(rows.Columns) != 3 || rows.Columns[2].ID != "360000123456" {
+		t.Fatalf("unexpected columns %v", rows.Columns)
+	}
+	if len(rows.Users) != 2 || len(rows.Groups) != 1 {
+		t.Fatalf("expected sideloaded users and groups, but got %d and %d", len(rows.Users), len(rows.Groups))
+	}
+
+	row := rows.Rows[0]
+	if row.Ticket.ID != 35436 || row.Ticket.Status != "open" {
+		t.Fatalf("unexpected ticket of row %v", row.Ticket)
+	}
+	if subject, ok := row.Value("subject"); !ok || subject != "Help I need somebody!" {
+		t.Fatalf("unexpected subject %v", subject)
+	}
+	if _, ok := row.Value("ticket"); ok {
+		t.Fatal("expected ticket not to be a column value")
+	}
+}
+
+func TestPreviewView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			View map[string]interface{} `json:"view"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatal(err)
+		}
+		if r.Method != http.MethodPost || r.URL.Path != "/views/preview.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if _, ok := data.View["title"]; ok {
+			t.Fatalf("expected only conditions and output to be sent, but got %v", data.View)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "view_preview.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	rows, err := client.PreviewView(ctx, View{
+		Conditions: ViewConditions{
+			All: []ViewCondition{{Field: "status", Operator: "less_than", Value: "solved"}},
+		},
+		Execution: ViewExecution{Columns: []ViewColumn{{ID: "subject"}, {ID: "requester"}}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Failed to preview view: %s", err)
+	}
+
+	if len(rows.Rows) != 2 {
+		t.Fatalf("expected 2 rows, but got %d", len(rows.Rows))
+	}
+}
+
+func TestGetViewCount(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "view_count.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.GetViewCount(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to get view count: %s", err)
+	}
+
+	if count.Value != 719 || !count.Fresh {
+		t.Fatalf("unexpected view count %v", count)
+	}
+}
+
+func TestExportView(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "view_export.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	export, err := client.ExportView(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to export view: %s", err)
+	}
+
+	if export.Status != "starting" {
+		t.Fatalf("expected status of export is starting, but got %s", export.Status)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/google/go-querystring/query"
 )
@@ -31,6 +32,10 @@ type (
 		httpClient *http.Client
 		credential Credential
 		headers    map[string]string
+
+		// slaPolicyDefinitions caches the definitions used to validate SLA policies
+		slaPolicyDefinitions   *SLAPolicyDefinitions
+		slaPolicyDefinitionsMu sync.Mutex
 	}
 
 	// BaseAPI encapsulates base methods for zendesk client
@@ -286,6 +291,37 @@ func (z *Client) delete(ctx context.Context, path string) error {
 	return nil
 }
 
+// deleteWithBody sends delete request to API and returns response body as []bytes.
+// It is used for the endpoints which respond with the deleted resource or a job status.
+func (z *Client) deleteWithBody(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodDelete, z.baseURL.String()+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = z.prepareRequest(ctx, req)
+
+	resp, err := z.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !(resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNoContent) {
+		return nil, Error{
+			body: body,
+			resp: resp,
+		}
+	}
+
+	return body, nil
+}
+
 // prepare request sets common request variables such as authn and user agent
 func (z *Client) prepareRequest(ctx context.Context, req *http.Request) *http.Request {
 	out := req.WithContext(ctx)